@@ -266,6 +266,58 @@ func (receiver Address) IsSomething() bool {
 	return receiver.optional.IsSomething()
 }
 
+// IsZero returns true if the receiver is the zero-value of Address — i.e., if it contains nothing.
+//
+// Note that IsZero is about the zero-value of the Go type.
+// It does NOT check for the zero eth-address (0x0000000000000000000000000000000000000000) — use Kind for that.
+// In particular, ethaddr.Zero().IsZero() returns FALSE:
+//
+//	ethaddr.Zero().IsZero()                  // false
+//	ethaddr.Zero().Kind() == ethaddr.KindZero // true
+//	ethaddr.Nothing().IsZero()               // true
+//
+// IsZero exists so that the "omitempty" (and "omitzero") options of various encoding packages leave out Nothing() (and only Nothing()).
+// (gopkg.in/yaml.v3 treats a struct with only unexported fields as always empty, unless it has an IsZero method, so this cannot be done some other way.)
+func (receiver Address) IsZero() bool {
+	return receiver.IsNothing()
+}
+
+// Kind classifies the eth-address.
+//
+// For example:
+//
+//	ethaddr.Zero().Kind()                  == ethaddr.KindZero
+//	ethaddr.Precompile(0x01).Kind()        == ethaddr.KindPrecompile
+//	ethaddr.NativeTokenSentinel().Kind()   == ethaddr.KindNativeTokenSentinel
+//	ethaddr.Dead().Kind()                  == ethaddr.KindBurn
+//	ethaddr.BeaconDepositContract().Kind() == ethaddr.KindSystem
+//	ethaddr.Nothing().Kind()               == ethaddr.KindNothing
+//
+// Any other eth-address is KindRegular.
+func (receiver Address) Kind() Kind {
+	value, something := receiver.optional.Get()
+	if !something {
+		return KindNothing
+	}
+
+	switch receiver {
+	case Zero():
+		return KindZero
+	case NativeTokenSentinel():
+		return KindNativeTokenSentinel
+	case Dead():
+		return KindBurn
+	case BeaconDepositContract(), SystemAddress(), BeaconRootsContract(), HistoryStorageContract(), WithdrawalRequestContract(), ConsolidationRequestContract():
+		return KindSystem
+	}
+
+	if isPrecompile(value) {
+		return KindPrecompile
+	}
+
+	return KindRegular
+}
+
 // MarshalBinary returns the eth-address in its binary form as a []byte.
 func (receiver Address) MarshalBinary() ([]byte, error) {
 	value, something := receiver.optional.Get()
@@ -0,0 +1,105 @@
+package ethaddr
+
+import (
+	"encoding/binary"
+
+	"github.com/reiver/go-erorr"
+)
+
+// These are the BSON element-types (and the BSON binary-subtype) that MarshalBSONValue and UnmarshalBSONValue deal with.
+//
+// See: https://bsonspec.org/spec.html
+const (
+	bsonTypeString byte = 0x02
+	bsonTypeBinary byte = 0x05
+	bsonTypeNull   byte = 0x0A
+
+	bsonBinarySubtypeGeneric byte = 0x00
+)
+
+// MarshalBSONValue makes it so Address can be stored in MongoDB.
+//
+// The signature matches the bson.ValueMarshaler interface of version 2 of the MongoDB Go driver (go.mongodb.org/mongo-driver/v2), which uses a byte for the BSON element-type.
+// (So this package does not need to import the driver.)
+//
+// It does NOT match the bson.ValueMarshaler interface of version 1 of the driver (go.mongodb.org/mongo-driver), which uses bsontype.Type rather than a byte —
+// so version 1 of the driver never calls it.
+// With version 1, wrap it in a type whose MarshalBSONValue converts the byte to a bsontype.Type.
+//
+// Something() is stored as a BSON binary (0x05) of subtype generic (0x00) holding the 20 bytes of the eth-address.
+//
+// Nothing() is stored as BSON null (0x0A).
+func (receiver Address) MarshalBSONValue() (byte, []byte, error) {
+	value, something := receiver.optional.Get()
+	if !something {
+		return bsonTypeNull, nil, nil
+	}
+
+	var data []byte = make([]byte, 0, 4+1+AddressLength)
+	data = binary.LittleEndian.AppendUint32(data, AddressLength)
+	data = append(data, bsonBinarySubtypeGeneric)
+	data = append(data, value[:]...)
+
+	return bsonTypeBinary, data, nil
+}
+
+// UnmarshalBSONValue makes it so Address can be loaded from MongoDB.
+//
+// The signature matches the bson.ValueUnmarshaler interface of version 2 of the MongoDB Go driver (go.mongodb.org/mongo-driver/v2).
+//
+// It does NOT match the bson.ValueUnmarshaler interface of version 1 of the driver (go.mongodb.org/mongo-driver), which uses bsontype.Type rather than a byte —
+// so version 1 of the driver never calls it.
+//
+// UnmarshalBSONValue accepts:
+//
+// • BSON null (0x0A) — which becomes Nothing(),
+//
+// • BSON binary (0x05) of subtype generic (0x00) holding 20 bytes, and
+//
+// • BSON string (0x02) holding a hexadecimal-literal that is parsed exactly the same way Parse does.
+func (receiver *Address) UnmarshalBSONValue(bsontype byte, data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	switch bsontype {
+	case bsonTypeNull:
+		*receiver = Nothing()
+		return nil
+	case bsonTypeBinary:
+		if len(data) < 4+1 {
+			return errBSONTruncated
+		}
+
+		var length uint32 = binary.LittleEndian.Uint32(data)
+		var subtype byte = data[4]
+		var payload []byte = data[4+1:]
+
+		if uint32(len(payload)) != length {
+			return erorr.Errorf("ethaddr: the declared length of the BSON binary (%d) does not match the actual length of its data (%d)", length, len(payload))
+		}
+		if bsonBinarySubtypeGeneric != subtype {
+			return erorr.Errorf("ethaddr: unsupported BSON binary subtype 0x%02X for eth-address — expected 0x%02X", subtype, bsonBinarySubtypeGeneric)
+		}
+
+		return receiver.UnmarshalBinary(payload)
+	case bsonTypeString:
+		if len(data) < 4+1 {
+			return errBSONTruncated
+		}
+
+		var length uint32 = binary.LittleEndian.Uint32(data)
+		var payload []byte = data[4:]
+
+		if uint32(len(payload)) != length {
+			return erorr.Errorf("ethaddr: the declared length of the BSON string (%d) does not match the actual length of its data (%d)", length, len(payload))
+		}
+		if 0x00 != payload[len(payload)-1] {
+			return errBSONMissingNul
+		}
+
+		return receiver.UnmarshalText(payload[:len(payload)-1])
+	default:
+		return erorr.Errorf("ethaddr: cannot unmarshal BSON type 0x%02X into eth-address — expected binary (0x05), string (0x02), or null (0x0A)", bsontype)
+	}
+}
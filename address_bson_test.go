@@ -0,0 +1,162 @@
+package ethaddr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestAddress_MarshalBSONValue(t *testing.T) {
+
+	tests := []struct{
+		Address ethaddr.Address
+		ExpectedType byte
+		ExpectedData []byte
+	}{
+		{
+			Address: ethaddr.Nothing(),
+			ExpectedType: 0x0A,
+			ExpectedData: nil,
+		},
+		{
+			Address: ethaddr.Something( [20]byte{0x5a,0xAe,0xb6,0x05,0x3F,0x3E,0x94,0xC9,0xb9,0xA0,0x9f,0x33,0x66,0x94,0x35,0xE7,0xEf,0x1B,0xeA,0xed} ),
+			ExpectedType: 0x05,
+			ExpectedData: []byte{0x14,0x00,0x00,0x00, 0x00, 0x5a,0xAe,0xb6,0x05,0x3F,0x3E,0x94,0xC9,0xb9,0xA0,0x9f,0x33,0x66,0x94,0x35,0xE7,0xEf,0x1B,0xeA,0xed},
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actualType, actualData, err := test.Address.MarshalBSONValue()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		if expected, actual := test.ExpectedType, actualType; expected != actual {
+			t.Errorf("For test #%d, the actual BSON type is not what was expected.", testNumber)
+			t.Logf("EXPECTED: 0x%02X", expected)
+			t.Logf("ACTUAL:   0x%02X", actual)
+			continue
+		}
+
+		if expected, actual := test.ExpectedData, actualData; !bytes.Equal(expected, actual) {
+			t.Errorf("For test #%d, the actual BSON data is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual)
+			continue
+		}
+
+		var address ethaddr.Address = ethaddr.Zero()
+		err = address.UnmarshalBSONValue(actualType, actualData)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error when round-tripping but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		if expected, actual := test.Address, address; expected != actual {
+			t.Errorf("For test #%d, the round-tripped address is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
+
+func TestAddress_UnmarshalBSONValue(t *testing.T) {
+
+	tests := []struct{
+		Type byte
+		Data []byte
+		Expected ethaddr.Address
+	}{
+		{
+			Type: 0x0A,
+			Data: nil,
+			Expected: ethaddr.Nothing(),
+		},
+		{
+			Type: 0x02,
+			Data: append([]byte{0x2B,0x00,0x00,0x00}, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed\x00"...),
+			Expected: ethaddr.Something( [20]byte{0x5a,0xAe,0xb6,0x05,0x3F,0x3E,0x94,0xC9,0xb9,0xA0,0x9f,0x33,0x66,0x94,0x35,0xE7,0xEf,0x1B,0xeA,0xed} ),
+		},
+		{
+			Type: 0x05,
+			Data: []byte{0x14,0x00,0x00,0x00, 0x00, 0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0xdE,0xaD},
+			Expected: ethaddr.Dead(),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual ethaddr.Address = ethaddr.Zero()
+		err := actual.UnmarshalBSONValue(test.Type, test.Data)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, the actual unmarshaled value is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
+
+func TestAddress_UnmarshalBSONValue_fail(t *testing.T) {
+
+	tests := []struct{
+		Type byte
+		Data []byte
+		ExpectedError string
+	}{
+		{
+			Type: 0x10,
+			Data: []byte{0x01,0x00,0x00,0x00},
+			ExpectedError: "ethaddr: cannot unmarshal BSON type 0x10 into eth-address — expected binary (0x05), string (0x02), or null (0x0A)",
+		},
+		{
+			Type: 0x05,
+			Data: []byte{0x14,0x00,0x00},
+			ExpectedError: "ethaddr: truncated BSON value",
+		},
+		{
+			Type: 0x05,
+			Data: []byte{0x14,0x00,0x00,0x00, 0x00, 0x01,0x02},
+			ExpectedError: "ethaddr: the declared length of the BSON binary (20) does not match the actual length of its data (2)",
+		},
+		{
+			Type: 0x05,
+			Data: []byte{0x14,0x00,0x00,0x00, 0x04, 0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0xdE,0xaD},
+			ExpectedError: "ethaddr: unsupported BSON binary subtype 0x04 for eth-address — expected 0x00",
+		},
+		{
+			Type: 0x02,
+			Data: append([]byte{0x2A,0x00,0x00,0x00}, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"...),
+			ExpectedError: "ethaddr: BSON string missing terminating NUL byte",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var address ethaddr.Address
+		err := address.UnmarshalBSONValue(test.Type, test.Data)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			continue
+		}
+
+		if expected, actual := test.ExpectedError, err.Error(); expected != actual {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			continue
+		}
+	}
+}
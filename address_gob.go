@@ -0,0 +1,23 @@
+package ethaddr
+
+import (
+	"encoding/gob"
+)
+
+var _ gob.GobEncoder = Address{}
+var _ gob.GobDecoder = &Address{}
+
+// GobEncode makes it so Address implements gob.GobEncoder.
+//
+// GobEncode uses the optional-preserving binary form (see MarshalOptionalBinary), so that Nothing() survives being gob-encoded.
+// (Without GobEncode, the "encoding/gob" package would use MarshalBinary, which returns an error for Nothing().)
+func (receiver Address) GobEncode() ([]byte, error) {
+	return receiver.MarshalOptionalBinary()
+}
+
+// GobDecode makes it so Address implements gob.GobDecoder.
+//
+// See GobEncode.
+func (receiver *Address) GobDecode(data []byte) error {
+	return receiver.UnmarshalOptionalBinary(data)
+}
@@ -0,0 +1,71 @@
+package ethaddr_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestAddress_gob(t *testing.T) {
+
+	type record struct {
+		Name string
+		From ethaddr.Address
+		To   ethaddr.Address
+	}
+
+	tests := []struct{
+		Record record
+	}{
+		{
+			Record: record{
+				Name: "nothing",
+			},
+		},
+		{
+			Record: record{
+				Name: "something",
+				From: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+				To:   ethaddr.ParseStringElsePanic("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"),
+			},
+		},
+		{
+			Record: record{
+				Name: "mixed",
+				From: ethaddr.Zero(),
+			},
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var buffer bytes.Buffer
+
+		err := gob.NewEncoder(&buffer).Encode(test.Record)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error when encoding but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("RECORD: %#v", test.Record)
+			continue
+		}
+
+		var actual record
+
+		err = gob.NewDecoder(&buffer).Decode(&actual)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error when decoding but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("RECORD: %#v", test.Record)
+			continue
+		}
+
+		if expected := test.Record; expected != actual {
+			t.Errorf("For test #%d, the actual decoded value is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
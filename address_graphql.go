@@ -0,0 +1,56 @@
+package ethaddr
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/reiver/go-erorr"
+)
+
+// MarshalGQL makes it so Address can be used as a custom GraphQL scalar with gqlgen (github.com/99designs/gqlgen).
+//
+// Something() is written as a (JSON) string of the EIP-55 / ERC-55 encoded hexadecimal-literal.
+// For example:
+//
+//	"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+//
+// Nothing() is written as null.
+func (receiver Address) MarshalGQL(writer io.Writer) {
+	if nil == writer {
+		return
+	}
+
+	if receiver.IsNothing() {
+		io.WriteString(writer, "null")
+		return
+	}
+
+	io.WriteString(writer, strconv.Quote(receiver.EIP55()))
+}
+
+// UnmarshalGQL makes it so Address can be used as a custom GraphQL scalar with gqlgen (github.com/99designs/gqlgen).
+//
+// The value must be a string, and is parsed exactly the same way Parse does.
+// Numbers are rejected — even though an eth-address is (in a sense) a number — since a GraphQL (or JSON) number cannot hold one without losing precision.
+//
+// nil becomes Nothing().
+func (receiver *Address) UnmarshalGQL(value interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	switch casted := value.(type) {
+	case nil:
+		*receiver = Nothing()
+		return nil
+	case string:
+		return receiver.UnmarshalText([]byte(casted))
+	case []byte:
+		return receiver.UnmarshalText(casted)
+	case json.Number, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return erorr.Errorf("ethaddr: GraphQL eth-address must be a string, but actually got a number (%v)", casted)
+	default:
+		return erorr.Errorf("ethaddr: GraphQL eth-address must be a string, but actually got %T", value)
+	}
+}
@@ -0,0 +1,142 @@
+package ethaddr_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestAddress_MarshalGQL(t *testing.T) {
+
+	tests := []struct{
+		Address ethaddr.Address
+		Expected string
+	}{
+		{
+			Address: ethaddr.Nothing(),
+			Expected: `null`,
+		},
+		{
+			Address: ethaddr.ParseStringElsePanic("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"),
+			Expected: `"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var builder strings.Builder
+		test.Address.MarshalGQL(&builder)
+
+		{
+			expected := test.Expected
+			actual := builder.String()
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual GraphQL value is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %s", expected)
+				t.Logf("ACTUAL:   %s", actual)
+				continue
+			}
+		}
+	}
+}
+
+func TestAddress_UnmarshalGQL(t *testing.T) {
+
+	tests := []struct{
+		Value interface{}
+		Expected ethaddr.Address
+	}{
+		{
+			Value: nil,
+			Expected: ethaddr.Nothing(),
+		},
+		{
+			Value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			Expected: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+		},
+		{
+			Value: "0x000000000000000000000000000000000000dead",
+			Expected: ethaddr.Dead(),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual ethaddr.Address = ethaddr.Zero()
+		err := actual.UnmarshalGQL(test.Value)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("VALUE: %#v", test.Value)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, the actual unmarshaled value is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual)
+			t.Logf("VALUE: %#v", test.Value)
+			continue
+		}
+	}
+}
+
+func TestAddress_UnmarshalGQL_fail(t *testing.T) {
+
+	tests := []struct{
+		Value interface{}
+		ExpectedError string
+	}{
+		{
+			Value: json.Number("57005"),
+			ExpectedError: "ethaddr: GraphQL eth-address must be a string, but actually got a number (57005)",
+		},
+		{
+			Value: int64(57005),
+			ExpectedError: "ethaddr: GraphQL eth-address must be a string, but actually got a number (57005)",
+		},
+		{
+			Value: float64(1.5),
+			ExpectedError: "ethaddr: GraphQL eth-address must be a string, but actually got a number (1.5)",
+		},
+		{
+			Value: true,
+			ExpectedError: "ethaddr: GraphQL eth-address must be a string, but actually got bool",
+		},
+		{
+			Value: map[string]interface{}{},
+			ExpectedError: "ethaddr: GraphQL eth-address must be a string, but actually got map[string]interface {}",
+		},
+		{
+			Value: "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			ExpectedError: "ethaddr: missing prefix for hexadecimal-literal (i.e., \"0x\")",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var address ethaddr.Address
+		err := address.UnmarshalGQL(test.Value)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("VALUE: %#v", test.Value)
+			continue
+		}
+
+		{
+			expected := test.ExpectedError
+			actual := err.Error()
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				t.Logf("VALUE: %#v", test.Value)
+				continue
+			}
+		}
+	}
+}
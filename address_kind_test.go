@@ -0,0 +1,166 @@
+package ethaddr_test
+
+import (
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestAddress_Kind(t *testing.T) {
+
+	tests := []struct{
+		Address ethaddr.Address
+		Expected ethaddr.Kind
+	}{
+		{
+			Address: ethaddr.Nothing(),
+			Expected: ethaddr.KindNothing,
+		},
+
+
+
+		{
+			Address: ethaddr.Zero(),
+			Expected: ethaddr.KindZero,
+		},
+		{
+			Address: ethaddr.Something( [20]byte{0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00} ),
+			Expected: ethaddr.KindZero,
+		},
+
+
+
+		{
+			Address: ethaddr.Precompile(0x01),
+			Expected: ethaddr.KindPrecompile,
+		},
+		{
+			Address: ethaddr.Precompile(0x0a),
+			Expected: ethaddr.KindPrecompile,
+		},
+		{
+			Address: ethaddr.Precompile(0x11),
+			Expected: ethaddr.KindPrecompile,
+		},
+		{
+			Address: ethaddr.Precompile(0x12),
+			Expected: ethaddr.KindRegular,
+		},
+		{
+			Address: ethaddr.Precompile(ethaddr.P256VerifyPrecompile),
+			Expected: ethaddr.KindPrecompile,
+		},
+		{
+			Address: ethaddr.Precompile(0x0101),
+			Expected: ethaddr.KindRegular,
+		},
+
+
+
+		{
+			Address: ethaddr.NativeTokenSentinel(),
+			Expected: ethaddr.KindNativeTokenSentinel,
+		},
+		{
+			Address: ethaddr.Dead(),
+			Expected: ethaddr.KindBurn,
+		},
+
+
+
+		{
+			Address: ethaddr.BeaconDepositContract(),
+			Expected: ethaddr.KindSystem,
+		},
+		{
+			Address: ethaddr.SystemAddress(),
+			Expected: ethaddr.KindSystem,
+		},
+		{
+			Address: ethaddr.BeaconRootsContract(),
+			Expected: ethaddr.KindSystem,
+		},
+		{
+			Address: ethaddr.HistoryStorageContract(),
+			Expected: ethaddr.KindSystem,
+		},
+		{
+			Address: ethaddr.WithdrawalRequestContract(),
+			Expected: ethaddr.KindSystem,
+		},
+		{
+			Address: ethaddr.ConsolidationRequestContract(),
+			Expected: ethaddr.KindSystem,
+		},
+
+
+
+		{
+			Address: ethaddr.Something( [20]byte{0x5a,0xAe,0xb6,0x05,0x3F,0x3E,0x94,0xC9,0xb9,0xA0,0x9f,0x33,0x66,0x94,0x35,0xE7,0xEf,0x1B,0xeA,0xed} ),
+			Expected: ethaddr.KindRegular,
+		},
+		{
+			Address: ethaddr.Something( [20]byte{0x01,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x01} ),
+			Expected: ethaddr.KindRegular,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Address.Kind()
+
+		expected := test.Expected
+
+		if expected != actual {
+			t.Errorf("For test #%d, the actual kind is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			t.Logf("ADDRESS: %#v", test.Address)
+			continue
+		}
+	}
+}
+
+// TestAddress_IsZero checks that IsZero is about the zero-value of the Go type (i.e., Nothing), and NOT about the zero eth-address.
+func TestAddress_IsZero(t *testing.T) {
+
+	tests := []struct{
+		Address ethaddr.Address
+		Expected bool
+	}{
+		{
+			Address: ethaddr.Address{},
+			Expected: true,
+		},
+		{
+			Address: ethaddr.Nothing(),
+			Expected: true,
+		},
+		{
+			// NOTE that the zero eth-address is NOT the zero-value.
+			Address: ethaddr.Zero(),
+			Expected: false,
+		},
+		{
+			Address: ethaddr.Dead(),
+			Expected: false,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		if expected, actual := test.Expected, test.Address.IsZero(); expected != actual {
+			t.Errorf("For test #%d, the actual value for is-zero is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %t", expected)
+			t.Logf("ACTUAL:   %t", actual)
+			t.Logf("ADDRESS: %#v", test.Address)
+			continue
+		}
+	}
+
+	if expected, actual := ethaddr.KindZero, ethaddr.Zero().Kind(); expected != actual {
+		t.Errorf("The actual kind of the zero eth-address is not what was expected.")
+		t.Logf("EXPECTED: %v", expected)
+		t.Logf("ACTUAL:   %v", actual)
+	}
+}
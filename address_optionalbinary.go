@@ -0,0 +1,72 @@
+package ethaddr
+
+import (
+	"github.com/reiver/go-erorr"
+)
+
+// These are the tag-bytes of the optional-preserving binary form of an eth-address.
+//
+// See MarshalOptionalBinary and UnmarshalOptionalBinary.
+const (
+	optionalBinaryTagNothing   byte = 0x00
+	optionalBinaryTagSomething byte = 0x01
+)
+
+// AppendOptionalBinary appends the optional-preserving binary form of the eth-address to 'dst'.
+//
+// See MarshalOptionalBinary for what the optional-preserving binary form is.
+func (receiver Address) AppendOptionalBinary(dst []byte) []byte {
+	value, something := receiver.optional.Get()
+	if !something {
+		return append(dst, optionalBinaryTagNothing)
+	}
+
+	dst = append(dst, optionalBinaryTagSomething)
+	return append(dst, value[:]...)
+}
+
+// MarshalOptionalBinary returns the optional-preserving binary form of the eth-address.
+//
+// Unlike MarshalBinary, MarshalOptionalBinary does NOT return an error for Nothing().
+//
+// The optional-preserving binary form starts with a tag-byte (that also acts as a version number).
+//
+// Nothing() is the single byte:
+//
+//	[]byte{0x00}
+//
+// And, Something() is the byte 0x01 followed by the 20 bytes of the eth-address.
+// For example:
+//
+//	// 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed
+//	[]byte{0x01, 0x5a,0xAe,0xb6,0x05,0x3F,0x3E,0x94,0xC9,0xb9,0xA0,0x9f,0x33,0x66,0x94,0x35,0xE7,0xEf,0x1B,0xeA,0xed}
+func (receiver Address) MarshalOptionalBinary() ([]byte, error) {
+	return receiver.AppendOptionalBinary(nil), nil
+}
+
+// UnmarshalOptionalBinary sets the receiver to the eth-address in its optional-preserving binary form.
+//
+// See MarshalOptionalBinary for what the optional-preserving binary form is.
+func (receiver *Address) UnmarshalOptionalBinary(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	if len(data) < 1 {
+		return errEmptyData
+	}
+
+	switch tag := data[0]; tag {
+	case optionalBinaryTagNothing:
+		if expected, actual := 1, len(data); expected != actual {
+			return erorr.Errorf("ethaddr: the actual length of the data parameter (%d) is not what was expected (%d) for nothing", actual, expected)
+		}
+
+		*receiver = Nothing()
+		return nil
+	case optionalBinaryTagSomething:
+		return receiver.UnmarshalBinary(data[1:])
+	default:
+		return erorr.Errorf("ethaddr: unknown tag-byte (0x%02X) for optional-preserving binary form of eth-address", tag)
+	}
+}
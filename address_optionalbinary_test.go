@@ -0,0 +1,122 @@
+package ethaddr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestAddress_MarshalOptionalBinary(t *testing.T) {
+
+	tests := []struct{
+		Address ethaddr.Address
+		Expected []byte
+	}{
+		{
+			Address: ethaddr.Nothing(),
+			Expected: []byte{0x00},
+		},
+		{
+			Address: ethaddr.Zero(),
+			Expected: []byte{0x01, 0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00},
+		},
+		{
+			Address: ethaddr.Something( [20]byte{0x5a,0xAe,0xb6,0x05,0x3F,0x3E,0x94,0xC9,0xb9,0xA0,0x9f,0x33,0x66,0x94,0x35,0xE7,0xEf,0x1B,0xeA,0xed} ),
+			Expected: []byte{0x01, 0x5a,0xAe,0xb6,0x05,0x3F,0x3E,0x94,0xC9,0xb9,0xA0,0x9f,0x33,0x66,0x94,0x35,0xE7,0xEf,0x1B,0xeA,0xed},
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, err := test.Address.MarshalOptionalBinary()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("ADDRESS: %#v", test.Address)
+			continue
+		}
+
+		{
+			expected := test.Expected
+
+			if !bytes.Equal(expected, actual) {
+				t.Errorf("For test #%d, the actual marshaled value is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %X", expected)
+				t.Logf("ACTUAL:   %X", actual)
+				t.Logf("ADDRESS: %#v", test.Address)
+				continue
+			}
+		}
+
+		{
+			var address ethaddr.Address = ethaddr.Dead()
+
+			err := address.UnmarshalOptionalBinary(actual)
+			if nil != err {
+				t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+				t.Logf("ERROR: (%T) %s", err, err)
+				t.Logf("ADDRESS: %#v", test.Address)
+				continue
+			}
+
+			if expected := test.Address; expected != address {
+				t.Errorf("For test #%d, the actual unmarshaled value is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %#v", expected)
+				t.Logf("ACTUAL:   %#v", address)
+				continue
+			}
+		}
+	}
+}
+
+func TestAddress_UnmarshalOptionalBinary_fail(t *testing.T) {
+
+	tests := []struct{
+		Data []byte
+		ExpectedError string
+	}{
+		{
+			Data: nil,
+			ExpectedError: "ethaddr: empty data",
+		},
+		{
+			Data: []byte{0x00, 0x00},
+			ExpectedError: "ethaddr: the actual length of the data parameter (2) is not what was expected (1) for nothing",
+		},
+		{
+			Data: []byte{0x01, 0x00},
+			ExpectedError: "ethaddr: the actual length of the data parameter (1) is not what was expected (20)",
+		},
+		{
+			Data: []byte{0x02, 0x5a,0xAe,0xb6,0x05,0x3F,0x3E,0x94,0xC9,0xb9,0xA0,0x9f,0x33,0x66,0x94,0x35,0xE7,0xEf,0x1B,0xeA,0xed},
+			ExpectedError: "ethaddr: unknown tag-byte (0x02) for optional-preserving binary form of eth-address",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var address ethaddr.Address
+		err := address.UnmarshalOptionalBinary(test.Data)
+
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("ADDRESS: %#v", address)
+			t.Logf("DATA: %X", test.Data)
+			continue
+		}
+
+		{
+			expected := test.ExpectedError
+			actual := err.Error()
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				t.Logf("DATA: %X", test.Data)
+				continue
+			}
+		}
+	}
+}
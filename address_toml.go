@@ -0,0 +1,45 @@
+package ethaddr
+
+import (
+	"strconv"
+
+	"github.com/reiver/go-erorr"
+)
+
+// MarshalTOML makes it so Address implements the toml.Marshaler interface (of the github.com/BurntSushi/toml package).
+//
+// MarshalTOML returns the EIP-55 / ERC-55 encoded hexadecimal-literal as a (quoted) TOML string.
+//
+// TOML does not have null, so (just like MarshalText) MarshalTOML returns an error for Nothing().
+// Use the "omitempty" struct-tag to leave out a Nothing() field instead.
+func (receiver Address) MarshalTOML() ([]byte, error) {
+	if receiver.IsNothing() {
+		return nil, errNothing
+	}
+
+	return []byte(strconv.Quote(receiver.EIP55())), nil
+}
+
+// UnmarshalTOML makes it so Address implements the toml.Unmarshaler interface (of the github.com/BurntSushi/toml package).
+//
+// The value must be a TOML string.
+//
+// TOML integers are rejected (rather than converted), since TOML hexadecimal integers (ex: 0xdead) lose the leading zeros,
+// and cannot hold a full eth-address anyways.
+// (I.e., the hexadecimal-literal of the eth-address needs to be in quotes.)
+func (receiver *Address) UnmarshalTOML(value interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	switch casted := value.(type) {
+	case string:
+		return receiver.UnmarshalText([]byte(casted))
+	case []byte:
+		return receiver.UnmarshalText(casted)
+	case int64:
+		return erorr.Errorf("ethaddr: expected TOML string for eth-address, but actually got TOML integer (%d) — try putting the eth-address in quotes", casted)
+	default:
+		return erorr.Errorf("ethaddr: expected TOML string for eth-address, but actually got %T", value)
+	}
+}
@@ -0,0 +1,140 @@
+package ethaddr_test
+
+import (
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestAddress_MarshalTOML(t *testing.T) {
+
+	tests := []struct{
+		Address ethaddr.Address
+		Expected string
+	}{
+		{
+			Address: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			Expected: `"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"`,
+		},
+		{
+			Address: ethaddr.Dead(),
+			Expected: `"0x000000000000000000000000000000000000dEaD"`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actualBytes, err := test.Address.MarshalTOML()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("ADDRESS: %#v", test.Address)
+			continue
+		}
+
+		{
+			expected := test.Expected
+			actual := string(actualBytes)
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual TOML is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				t.Logf("ADDRESS: %#v", test.Address)
+				continue
+			}
+		}
+	}
+}
+
+func TestAddress_MarshalTOML_nothing(t *testing.T) {
+
+	_, err := ethaddr.Nothing().MarshalTOML()
+	if nil == err {
+		t.Errorf("Expected an error but did not actually get one.")
+		return
+	}
+}
+
+func TestAddress_UnmarshalTOML(t *testing.T) {
+
+	tests := []struct{
+		Value interface{}
+		Expected ethaddr.Address
+	}{
+		{
+			Value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			Expected: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+		},
+		{
+			Value: "0x000000000000000000000000000000000000dead",
+			Expected: ethaddr.Dead(),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual ethaddr.Address
+		err := actual.UnmarshalTOML(test.Value)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("VALUE: %#v", test.Value)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, the actual unmarshaled value is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual)
+			t.Logf("VALUE: %#v", test.Value)
+			continue
+		}
+	}
+}
+
+func TestAddress_UnmarshalTOML_fail(t *testing.T) {
+
+	tests := []struct{
+		Value interface{}
+		ExpectedError string
+	}{
+		{
+			// what a TOML library gives for: treasury = 0xdead
+			Value: int64(0xdead),
+			ExpectedError: "ethaddr: expected TOML string for eth-address, but actually got TOML integer (57005) — try putting the eth-address in quotes",
+		},
+		{
+			Value: true,
+			ExpectedError: "ethaddr: expected TOML string for eth-address, but actually got bool",
+		},
+		{
+			Value: "dead",
+			ExpectedError: "ethaddr: missing prefix for hexadecimal-literal (i.e., \"0x\")",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var address ethaddr.Address
+		err := address.UnmarshalTOML(test.Value)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("VALUE: %#v", test.Value)
+			continue
+		}
+
+		{
+			expected := test.ExpectedError
+			actual := err.Error()
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				t.Logf("VALUE: %#v", test.Value)
+				continue
+			}
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package ethaddr_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/reiver/go-ethaddr"
@@ -599,3 +600,53 @@ func TestAddress_UnmarshalText_error(t *testing.T) {
 		}
 	}
 }
+
+func TestParse_errorKind(t *testing.T) {
+	tests := []struct{
+		Text string
+		Expected error
+	}{
+		{
+			Text: "",
+			Expected: ethaddr.ErrMissingPrefix,
+		},
+		{
+			Text: "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			Expected: ethaddr.ErrMissingPrefix,
+		},
+		{
+			Text: "0x",
+			Expected: ethaddr.ErrBadLength,
+		},
+		{
+			Text: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed00",
+			Expected: ethaddr.ErrBadLength,
+		},
+		{
+			Text: "0xW000000000000000000000000000000000000000",
+			Expected: ethaddr.ErrBadHexadecimalSymbol,
+		},
+		{
+			Text: "0x00000000000000000000000000000000000000W",
+			Expected: ethaddr.ErrBadHexadecimalSymbol,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, err := ethaddr.ParseString(test.Text)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("TEXT: %q", test.Text)
+			continue
+		}
+
+		if !errors.Is(err, test.Expected) {
+			t.Errorf("For test #%d, the actual error is not the kind that was expected.", testNumber)
+			t.Logf("EXPECTED: %q", test.Expected)
+			t.Logf("ACTUAL:   %q", err)
+			t.Logf("TEXT: %q", test.Text)
+			continue
+		}
+	}
+}
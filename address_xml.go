@@ -0,0 +1,83 @@
+package ethaddr
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+var _ xml.Marshaler = Address{}
+var _ xml.Unmarshaler = &Address{}
+var _ xml.MarshalerAttr = Address{}
+var _ xml.UnmarshalerAttr = &Address{}
+
+// MarshalXML makes it so Address implements xml.Marshaler.
+//
+// Something() is marshaled as an element whose character-data is the EIP-55 / ERC-55 encoded hexadecimal-literal.
+// For example:
+//
+//	<wallet>0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed</wallet>
+//
+// Nothing() is left out (i.e., no element at all).
+func (receiver Address) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if receiver.IsNothing() {
+		return nil
+	}
+
+	return encoder.EncodeElement(receiver.EIP55(), start)
+}
+
+// UnmarshalXML makes it so Address implements xml.Unmarshaler.
+//
+// Whitespace around the hexadecimal-literal is ignored.
+// An empty element becomes Nothing().
+func (receiver *Address) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	var text string
+	if err := decoder.DecodeElement(&text, &start); nil != err {
+		return err
+	}
+
+	return receiver.unmarshalXMLText(text)
+}
+
+// MarshalXMLAttr makes it so Address implements xml.MarshalerAttr.
+//
+// Something() is marshaled as an attribute whose value is the EIP-55 / ERC-55 encoded hexadecimal-literal.
+// For example:
+//
+//	<transfer to="0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"/>
+//
+// Nothing() is left out (i.e., no attribute at all).
+func (receiver Address) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if receiver.IsNothing() {
+		return xml.Attr{}, nil
+	}
+
+	return xml.Attr{Name: name, Value: receiver.EIP55()}, nil
+}
+
+// UnmarshalXMLAttr makes it so Address implements xml.UnmarshalerAttr.
+//
+// Whitespace around the hexadecimal-literal is ignored.
+// An empty attribute becomes Nothing().
+func (receiver *Address) UnmarshalXMLAttr(attr xml.Attr) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	return receiver.unmarshalXMLText(attr.Value)
+}
+
+func (receiver *Address) unmarshalXMLText(text string) error {
+	text = strings.TrimSpace(text)
+
+	if "" == text {
+		*receiver = Nothing()
+		return nil
+	}
+
+	return receiver.UnmarshalText([]byte(text))
+}
@@ -0,0 +1,173 @@
+package ethaddr_test
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+type xmlTransfer struct {
+	XMLName xml.Name        `xml:"transfer"`
+	From    ethaddr.Address `xml:"from,attr"`
+	To      ethaddr.Address `xml:"to"`
+}
+
+func TestAddress_MarshalXML(t *testing.T) {
+
+	tests := []struct{
+		Transfer xmlTransfer
+		Expected string
+	}{
+		{
+			Transfer: xmlTransfer{},
+			Expected: `<transfer></transfer>`,
+		},
+		{
+			Transfer: xmlTransfer{
+				From: ethaddr.ParseStringElsePanic("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"),
+			},
+			Expected: `<transfer from="0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"></transfer>`,
+		},
+		{
+			Transfer: xmlTransfer{
+				To: ethaddr.ParseStringElsePanic("0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359"),
+			},
+			Expected: `<transfer><to>0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359</to></transfer>`,
+		},
+		{
+			Transfer: xmlTransfer{
+				From: ethaddr.ParseStringElsePanic("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"),
+				To:   ethaddr.ParseStringElsePanic("0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359"),
+			},
+			Expected: `<transfer from="0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"><to>0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359</to></transfer>`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actualBytes, err := xml.Marshal(test.Transfer)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		{
+			expected := test.Expected
+			actual := string(actualBytes)
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual XML is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %s", expected)
+				t.Logf("ACTUAL:   %s", actual)
+				continue
+			}
+		}
+
+		{
+			var actual xmlTransfer
+			if err := xml.Unmarshal(actualBytes, &actual); nil != err {
+				t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+				t.Logf("ERROR: (%T) %s", err, err)
+				continue
+			}
+
+			if expected := test.Transfer; expected.From != actual.From || expected.To != actual.To {
+				t.Errorf("For test #%d, the actual round-tripped value is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %#v", expected)
+				t.Logf("ACTUAL:   %#v", actual)
+				continue
+			}
+		}
+	}
+}
+
+func TestAddress_UnmarshalXML(t *testing.T) {
+
+	tests := []struct{
+		XML string
+		ExpectedFrom ethaddr.Address
+		ExpectedTo ethaddr.Address
+	}{
+		{
+			XML: `<transfer from="" />`,
+		},
+		{
+			XML: "<transfer>\n\t<to>\n\t\t0x000000000000000000000000000000000000dEaD\n\t</to>\n</transfer>",
+			ExpectedTo: ethaddr.Dead(),
+		},
+		{
+			XML: `<transfer from=" 0x000000000000000000000000000000000000dead "><to></to></transfer>`,
+			ExpectedFrom: ethaddr.Dead(),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual xmlTransfer
+		if err := xml.Unmarshal([]byte(test.XML), &actual); nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("XML: %s", test.XML)
+			continue
+		}
+
+		if expected := test.ExpectedFrom; expected != actual.From {
+			t.Errorf("For test #%d, the actual 'from' is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual.From)
+			t.Logf("XML: %s", test.XML)
+			continue
+		}
+
+		if expected := test.ExpectedTo; expected != actual.To {
+			t.Errorf("For test #%d, the actual 'to' is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual.To)
+			t.Logf("XML: %s", test.XML)
+			continue
+		}
+	}
+}
+
+func TestAddress_UnmarshalXML_fail(t *testing.T) {
+
+	tests := []struct{
+		XML string
+		ExpectedError string
+	}{
+		{
+			XML: `<transfer from="dead" />`,
+			ExpectedError: "ethaddr: missing prefix for hexadecimal-literal (i.e., \"0x\")",
+		},
+		{
+			XML: `<transfer><to>0xZZ</to></transfer>`,
+			ExpectedError: "ethaddr: the eth-address is expected to be 42 or 41 bytes long, but was actually 4 bytes long",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual xmlTransfer
+		err := xml.Unmarshal([]byte(test.XML), &actual)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("XML: %s", test.XML)
+			continue
+		}
+
+		{
+			expected := test.ExpectedError
+			actual := err.Error()
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				t.Logf("XML: %s", test.XML)
+				continue
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package ethaddr
+
+import (
+	"github.com/reiver/go-erorr"
+	"gopkg.in/yaml.v3"
+)
+
+var _ yaml.Marshaler = Address{}
+var _ yaml.Unmarshaler = &Address{}
+
+// MarshalYAML makes it so Address implements yaml.Marshaler.
+//
+// Something() is marshaled as the EIP-55 / ERC-55 encoded hexadecimal-literal, in double-quotes.
+// (The double-quotes make it so other YAML parsers do not mistake it for an integer.)
+// Nothing() is marshaled as null.
+func (receiver Address) MarshalYAML() (interface{}, error) {
+	if receiver.IsNothing() {
+		return nil, nil
+	}
+
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   "!!str",
+		Style: yaml.DoubleQuotedStyle,
+		Value: receiver.EIP55(),
+	}, nil
+}
+
+// UnmarshalYAML makes it so Address implements yaml.Unmarshaler.
+//
+// UnmarshalYAML works off of the YAML node (rather than the decoded value), so that it sees the hexadecimal-literal exactly as it was written.
+// This matters because YAML would otherwise treat an unquoted hexadecimal-literal, such as:
+//
+//	treasury: 0x000000000000000000000000000000000000dEaD
+//
+// as an integer — and lose its leading zeros (or fail to fit it in an int64).
+//
+// null (and an empty value) become Nothing().
+func (receiver *Address) UnmarshalYAML(value *yaml.Node) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	if nil == value {
+		return errNilYAMLNode
+	}
+
+	if yaml.ScalarNode != value.Kind {
+		return erorr.Errorf("ethaddr: expected YAML scalar for eth-address, but actually got YAML node kind %d (at line %d, column %d)", value.Kind, value.Line, value.Column)
+	}
+
+	switch value.ShortTag() {
+	case "!!null":
+		*receiver = Nothing()
+		return nil
+	case "!!str", "!!int":
+		// these are OK.
+	default:
+		return erorr.Errorf("ethaddr: expected YAML string (or hexadecimal integer) for eth-address, but actually got %s %q (at line %d, column %d)", value.ShortTag(), value.Value, value.Line, value.Column)
+	}
+
+	err := receiver.UnmarshalText([]byte(value.Value))
+	if nil != err {
+		return erorr.Errorf("ethaddr: problem with YAML eth-address %q (at line %d, column %d): %w", value.Value, value.Line, value.Column, err)
+	}
+
+	return nil
+}
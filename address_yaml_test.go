@@ -0,0 +1,199 @@
+package ethaddr_test
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestAddress_UnmarshalYAML(t *testing.T) {
+
+	type config struct {
+		Treasury ethaddr.Address `yaml:"treasury"`
+	}
+
+	tests := []struct{
+		YAML string
+		Expected ethaddr.Address
+	}{
+		{
+			YAML: `treasury: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"`,
+			Expected: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+		},
+		{
+			YAML: `treasury: '0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed'`,
+			Expected: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+		},
+		{
+			YAML: `treasury: 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed`,
+			Expected: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+		},
+		{
+			// looks like a (small) integer to YAML.
+			YAML: `treasury: 0x000000000000000000000000000000000000dEaD`,
+			Expected: ethaddr.Dead(),
+		},
+		{
+			YAML: `treasury: 0x0000000000000000000000000000000000000000`,
+			Expected: ethaddr.Zero(),
+		},
+		{
+			YAML: `treasury: null`,
+			Expected: ethaddr.Nothing(),
+		},
+		{
+			YAML: `treasury: ~`,
+			Expected: ethaddr.Nothing(),
+		},
+		{
+			YAML: `treasury:`,
+			Expected: ethaddr.Nothing(),
+		},
+		{
+			YAML: `other: 1`,
+			Expected: ethaddr.Nothing(),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual config
+		err := yaml.Unmarshal([]byte(test.YAML), &actual)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("YAML: %s", test.YAML)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual.Treasury {
+			t.Errorf("For test #%d, the actual unmarshaled value is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual.Treasury)
+			t.Logf("YAML: %s", test.YAML)
+			continue
+		}
+	}
+}
+
+func TestAddress_UnmarshalYAML_fail(t *testing.T) {
+
+	type config struct {
+		Treasury ethaddr.Address `yaml:"treasury"`
+	}
+
+	tests := []struct{
+		YAML string
+		ExpectedError string
+	}{
+		{
+			YAML: `treasury: 12345`,
+			ExpectedError: `ethaddr: problem with YAML eth-address "12345" (at line 1, column 11): ethaddr: missing prefix for hexadecimal-literal (i.e., "0x")`,
+		},
+		{
+			YAML: `treasury: 1.5`,
+			ExpectedError: `ethaddr: expected YAML string (or hexadecimal integer) for eth-address, but actually got !!float "1.5" (at line 1, column 11)`,
+		},
+		{
+			YAML: `treasury: true`,
+			ExpectedError: `ethaddr: expected YAML string (or hexadecimal integer) for eth-address, but actually got !!bool "true" (at line 1, column 11)`,
+		},
+		{
+			YAML: "treasury:\n  - 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			ExpectedError: `ethaddr: expected YAML scalar for eth-address, but actually got YAML node kind 2 (at line 2, column 3)`,
+		},
+		{
+			YAML: `treasury: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1Be"`,
+			ExpectedError: `ethaddr: problem with YAML eth-address "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1Be" (at line 1, column 11): ethaddr: the eth-address is expected to be 42 or 41 bytes long, but was actually 39 bytes long`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var actual config
+		err := yaml.Unmarshal([]byte(test.YAML), &actual)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("YAML: %s", test.YAML)
+			continue
+		}
+
+		{
+			expected := test.ExpectedError
+			actual := err.Error()
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				t.Logf("YAML: %s", test.YAML)
+				continue
+			}
+		}
+	}
+}
+
+func TestAddress_MarshalYAML(t *testing.T) {
+
+	type config struct {
+		Treasury ethaddr.Address `yaml:"treasury"`
+		Admin    ethaddr.Address `yaml:"admin,omitempty"`
+	}
+
+	tests := []struct{
+		Config config
+		Expected string
+	}{
+		{
+			Config: config{},
+			Expected: "treasury: null\n",
+		},
+		{
+			Config: config{
+				Treasury: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+				Admin:    ethaddr.Dead(),
+			},
+			Expected: "treasury: \"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\"\nadmin: \"0x000000000000000000000000000000000000dEaD\"\n",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actualBytes, err := yaml.Marshal(test.Config)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		{
+			expected := test.Expected
+			actual := string(actualBytes)
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual YAML is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				continue
+			}
+		}
+
+		{
+			var actual config
+			if err := yaml.Unmarshal(actualBytes, &actual); nil != err {
+				t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+				t.Logf("ERROR: (%T) %s", err, err)
+				continue
+			}
+
+			if expected := test.Config; expected != actual {
+				t.Errorf("For test #%d, the actual round-tripped value is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %#v", expected)
+				t.Logf("ACTUAL:   %#v", actual)
+				continue
+			}
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package analysis
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	goanalysis "golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+const ethaddrPackagePath = "github.com/reiver/go-ethaddr"
+
+// parseFuncs are the funcs (of package ethaddr) whose literal arguments are checked.
+//
+// The value is true if the argument is a []byte (rather than a string).
+var parseFuncs = map[string]bool{
+	"Parse":                true,
+	"ParseElsePanic":       true,
+	"ParseString":          false,
+	"ParseStringElsePanic": false,
+}
+
+// Analyzer checks literal (and constant) arguments to ethaddr.Parse, ethaddr.ParseElsePanic, ethaddr.ParseString, and ethaddr.ParseStringElsePanic.
+//
+// It reports any that are not valid eth-addresses, that have a bad EIP-55 / ERC-55 checksum, or that are not in EIP-55 / ERC-55 form.
+// If the argument is a literal, and there is exactly one likely fix, then it suggests a fix that rewrites it. (Otherwise the candidates are listed in the message.)
+var Analyzer = &goanalysis.Analyzer{
+	Name:     "ethaddr",
+	Doc:      "check eth-address literals passed to ethaddr.Parse*, and suggest their EIP-55 / ERC-55 form",
+	Requires: []*goanalysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *goanalysis.Pass) (interface{}, error) {
+	var inspect *inspector.Inspector = pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	inspect.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(node ast.Node) {
+		var call *ast.CallExpr = node.(*ast.CallExpr)
+		if 1 != len(call.Args) {
+			return
+		}
+
+		isBytes, found := parseFunc(pass, call)
+		if !found {
+			return
+		}
+
+		var arg ast.Expr = call.Args[0]
+		if isBytes {
+			arg = bytesConversionArg(pass, arg)
+			if nil == arg {
+				return
+			}
+		}
+
+		var tv types.TypeAndValue = pass.TypesInfo.Types[arg]
+		if nil == tv.Value || constant.String != tv.Value.Kind() {
+			return
+		}
+
+		var literal *ast.BasicLit
+		if lit, casted := ast.Unparen(arg).(*ast.BasicLit); casted {
+			literal = lit
+		}
+
+		check(pass, arg, literal, constant.StringVal(tv.Value))
+	})
+
+	return nil, nil
+}
+
+// parseFunc returns whether 'call' is a call to one of the parseFuncs, and if so whether its argument is a []byte.
+func parseFunc(pass *goanalysis.Pass, call *ast.CallExpr) (isBytes bool, found bool) {
+	var ident *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	default:
+		return false, false
+	}
+
+	fn, casted := pass.TypesInfo.Uses[ident].(*types.Func)
+	if !casted || nil == fn.Pkg() || ethaddrPackagePath != fn.Pkg().Path() {
+		return false, false
+	}
+
+	isBytes, found = parseFuncs[fn.Name()]
+	return isBytes, found
+}
+
+// bytesConversionArg returns 'x' if 'expr' is the conversion []byte(x), otherwise it returns nil.
+func bytesConversionArg(pass *goanalysis.Pass, expr ast.Expr) ast.Expr {
+	conversion, casted := ast.Unparen(expr).(*ast.CallExpr)
+	if !casted || 1 != len(conversion.Args) {
+		return nil
+	}
+
+	if !pass.TypesInfo.Types[conversion.Fun].IsType() {
+		return nil
+	}
+
+	return conversion.Args[0]
+}
+
+// maxListedSuggestions is the most suggestions that are listed in a diagnostic's message.
+const maxListedSuggestions = 5
+
+// check reports any problem with the eth-address 'text' (which is the value of 'arg').
+//
+// If 'literal' is not nil, and there is exactly one suggestion, then the suggested fix replaces it.
+// (All the suggestions would edit the same literal, so only one of them could ever be applied.)
+// If there is more than one suggestion, then (some of) them are listed in the message instead.
+func check(pass *goanalysis.Pass, arg ast.Expr, literal *ast.BasicLit, text string) {
+	suggestions, diagnoseErr := ethaddr.Diagnose(text)
+
+	address, err := ethaddr.ParseString(text)
+	switch {
+	case nil != err:
+		pass.Report(goanalysis.Diagnostic{
+			Pos:            arg.Pos(),
+			End:            arg.End(),
+			Message:        fmt.Sprintf("invalid eth-address literal %q: %s", text, strings.TrimPrefix(err.Error(), "ethaddr: ")) + listSuggestions(suggestions),
+			SuggestedFixes: suggestedFixes(literal, suggestions),
+		})
+	case nil != diagnoseErr:
+		pass.Report(goanalysis.Diagnostic{
+			Pos:            arg.Pos(),
+			End:            arg.End(),
+			Message:        fmt.Sprintf("eth-address literal %q: %s", text, strings.TrimPrefix(diagnoseErr.Error(), "ethaddr: ")) + listSuggestions(suggestions),
+			SuggestedFixes: suggestedFixes(literal, suggestions),
+		})
+	case address.EIP55() != text:
+		pass.Report(goanalysis.Diagnostic{
+			Pos:     arg.Pos(),
+			End:     arg.End(),
+			Message: fmt.Sprintf("eth-address literal %q is not in EIP-55 / ERC-55 form %q", text, address.EIP55()),
+			SuggestedFixes: suggestedFixes(literal, []ethaddr.Suggestion{
+				{Address: address},
+			}),
+		})
+	}
+}
+
+// listSuggestions returns the text, to append to a message, that lists (up to maxListedSuggestions of) the suggestions, if there is more than one of them.
+func listSuggestions(suggestions []ethaddr.Suggestion) string {
+	if len(suggestions) < 2 {
+		return ""
+	}
+
+	var listed []string
+	for index, suggestion := range suggestions {
+		if maxListedSuggestions <= index {
+			break
+		}
+		listed = append(listed, suggestion.Address.EIP55())
+	}
+
+	var result string = " (did you mean one of: " + strings.Join(listed, ", ")
+	if more := len(suggestions) - len(listed); 0 < more {
+		result += fmt.Sprintf(", or %d more", more)
+	}
+	return result + "?)"
+}
+
+// suggestedFixes returns a suggested fix, that replaces 'literal', if there is exactly one suggestion.
+func suggestedFixes(literal *ast.BasicLit, suggestions []ethaddr.Suggestion) []goanalysis.SuggestedFix {
+	if nil == literal || token.STRING != literal.Kind {
+		return nil
+	}
+	if 1 != len(suggestions) {
+		return nil
+	}
+
+	var fixes []goanalysis.SuggestedFix
+	for _, suggestion := range suggestions {
+		var eip55 string = suggestion.Address.EIP55()
+
+		var message string = "Replace with " + eip55
+		if 0 != suggestion.Mistake {
+			message = fmt.Sprintf("Replace with %s (%s at position %d)", eip55, suggestion.Mistake, suggestion.Position)
+		}
+
+		fixes = append(fixes, goanalysis.SuggestedFix{
+			Message: message,
+			TextEdits: []goanalysis.TextEdit{
+				{
+					Pos:     literal.Pos(),
+					End:     literal.End(),
+					NewText: []byte(strconv.Quote(eip55)),
+				},
+			},
+		})
+	}
+
+	return fixes
+}
@@ -0,0 +1,22 @@
+// Command ethaddrvet checks the eth-address literals passed to ethaddr.Parse, ethaddr.ParseElsePanic, ethaddr.ParseString, and ethaddr.ParseStringElsePanic.
+//
+// It can be run by itself:
+//
+//	ethaddrvet ./...
+//
+// Or with "go vet":
+//
+//	go vet -vettool=$(which ethaddrvet) ./...
+//
+// Use the -fix flag (when run by itself) to apply the suggested fixes.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/reiver/go-ethaddr/analysis"
+)
+
+func main() {
+	singlechecker.Main(analysis.Analyzer)
+}
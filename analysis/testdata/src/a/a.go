@@ -0,0 +1,20 @@
+package a
+
+import (
+	"github.com/reiver/go-ethaddr"
+)
+
+const treasury = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+
+func f(text string) {
+	ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	ethaddr.ParseStringElsePanic(text)
+
+	ethaddr.ParseStringElsePanic("0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359") // want `eth-address literal "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359" is not in EIP-55 / ERC-55 form "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"`
+	ethaddr.ParseString(`0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD`)           // want `eth-address literal "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD": bad EIP-55 / ERC-55 checksum`
+	ethaddr.ParseElsePanic([]byte("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAe"))   // want `eth-address literal "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAe": eth-address has 39 hexadecimal-symbols rather than 40 \(a hexadecimal-symbol was probably dropped\)`
+	ethaddr.Parse([]byte("0xdead"))                                               // want `invalid eth-address literal "0xdead": the eth-address is expected to be 42 or 41 bytes long, but was actually 6 bytes long`
+	ethaddr.ParseString("dbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB")               // want `invalid eth-address literal "dbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB": missing prefix for hexadecimal-literal`
+	ethaddr.ParseString("0x5aaeb6053f3e94c9b9a09f3669435e7ef1beaed")              // want `eth-address literal "0x5aaeb6053f3e94c9b9a09f3669435e7ef1beaed": eth-address has 39 hexadecimal-symbols .* \(did you mean one of: 0x\w{40}, 0x\w{40}, 0x\w{40}, 0x\w{40}, 0x\w{40}, or \d+ more\?\)`
+	ethaddr.ParseString(treasury)                                                 // want `eth-address literal "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed" is not in EIP-55 / ERC-55 form "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"`
+}
@@ -0,0 +1,176 @@
+package blockies
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strconv"
+	"strings"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+const (
+	DefaultSize  = 8
+	DefaultScale = 4
+)
+
+// Cell values of Blockie.Data.
+const (
+	Background = 0
+	Foreground = 1
+	Spot       = 2
+)
+
+// Blockie is the "blockies" identicon of an eth-address — the same one that MetaMask (and the JavaScript ethereum-blockies library) shows.
+//
+// Data holds Size×Size cells (row by row), where each cell is Background, Foreground, or Spot.
+type Blockie struct {
+	Size            int
+	Data            []byte
+	Color           HSL
+	BackgroundColor HSL
+	SpotColor       HSL
+}
+
+// Generate returns the (8×8) blockie for an eth-address.
+//
+// For example:
+//
+//	blockie, err := blockies.Generate(address)
+//	
+//	pngBytes, err := blockie.PNG(blockies.DefaultScale)
+func Generate(address ethaddr.Address) (Blockie, error) {
+	return GenerateSize(address, DefaultSize)
+}
+
+// GenerateSize is similar to Generate, except that the blockie is size×size cells.
+func GenerateSize(address ethaddr.Address, size int) (Blockie, error) {
+	if address.IsNothing() {
+		return Blockie{}, errNothing
+	}
+	if size <= 0 {
+		return Blockie{}, errBadSize
+	}
+
+	// MetaMask seeds with the lower-case hexadecimal-literal.
+	return generate(strings.ToLower(address.String()), size), nil
+}
+
+func generate(seed string, size int) Blockie {
+	var r *random = newRandom(seed)
+
+	var blockie = Blockie{
+		Size: size,
+	}
+
+	// The order here matters, since each call advances the pseudo-random number generator.
+	blockie.Color           = r.color()
+	blockie.BackgroundColor = r.color()
+	blockie.SpotColor       = r.color()
+
+	{
+		var dataWidth   int = (size + 1) / 2
+		var mirrorWidth int = size - dataWidth
+
+		blockie.Data = make([]byte, 0, size*size)
+
+		for y:=0; y<size; y++ {
+			var row []byte = make([]byte, size)
+
+			for x:=0; x<dataWidth; x++ {
+				row[x] = byte(r.next() * 2.3)
+			}
+			for x:=0; x<mirrorWidth; x++ {
+				row[dataWidth+x] = row[mirrorWidth-1-x]
+			}
+
+			blockie.Data = append(blockie.Data, row...)
+		}
+	}
+
+	return blockie
+}
+
+func (receiver Blockie) colorOf(cell byte) HSL {
+	switch cell {
+	case Foreground:
+		return receiver.Color
+	case Spot:
+		return receiver.SpotColor
+	default:
+		return receiver.BackgroundColor
+	}
+}
+
+// Image returns the blockie as an image, where each cell is scale×scale pixels.
+func (receiver Blockie) Image(scale int) (image.Image, error) {
+	if scale <= 0 {
+		return nil, errBadScale
+	}
+
+	var width int = receiver.Size * scale
+	var img *image.RGBA = image.NewRGBA(image.Rect(0, 0, width, width))
+
+	for i, cell := range receiver.Data {
+		r, g, b := receiver.colorOf(cell).RGB()
+		var c = color.RGBA{R: r, G: g, B: b, A: 0xFF}
+
+		var x0 int = (i % receiver.Size) * scale
+		var y0 int = (i / receiver.Size) * scale
+
+		for y:=y0; y<y0+scale; y++ {
+			for x:=x0; x<x0+scale; x++ {
+				img.SetRGBA(x, y, c)
+			}
+		}
+	}
+
+	return img, nil
+}
+
+// PNG returns the blockie as a PNG image, where each cell is scale×scale pixels.
+func (receiver Blockie) PNG(scale int) ([]byte, error) {
+	img, err := receiver.Image(scale)
+	if nil != err {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); nil != err {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// SVG returns the blockie as an SVG image, where each cell is scale×scale pixels.
+func (receiver Blockie) SVG(scale int) (string, error) {
+	if scale <= 0 {
+		return "", errBadScale
+	}
+
+	var size  string = strconv.Itoa(receiver.Size)
+	var width string = strconv.Itoa(receiver.Size * scale)
+
+	var builder strings.Builder
+
+	builder.WriteString(`<svg xmlns="http://www.w3.org/2000/svg" width="` + width + `" height="` + width + `" viewBox="0 0 ` + size + ` ` + size + `" shape-rendering="crispEdges">`)
+	builder.WriteString(`<rect width="` + size + `" height="` + size + `" fill="` + receiver.BackgroundColor.String() + `"/>`)
+
+	for i, cell := range receiver.Data {
+		if Background == cell {
+			continue
+		}
+
+		var x string = strconv.Itoa(i % receiver.Size)
+		var y string = strconv.Itoa(i / receiver.Size)
+
+		builder.WriteString(`<rect x="` + x + `" y="` + y + `" width="1" height="1" fill="` + receiver.colorOf(cell).String() + `"/>`)
+	}
+
+	builder.WriteString(`</svg>`)
+
+	return builder.String(), nil
+}
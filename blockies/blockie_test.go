@@ -0,0 +1,225 @@
+package blockies_test
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+	"github.com/reiver/go-ethaddr/blockies"
+)
+
+// The expected values were produced by the (JavaScript) ethereum-blockies library.
+func TestGenerateSize(t *testing.T) {
+
+	tests := []struct{
+		Address ethaddr.Address
+		Size int
+		ExpectedColor string
+		ExpectedBackgroundColor string
+		ExpectedSpotColor string
+		ExpectedData string
+	}{
+		{
+			Address: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			Size: 8,
+			ExpectedColor:           "hsl(222,80.46593819744885%,37.79142468702048%)",
+			ExpectedBackgroundColor: "hsl(160,99.25892420113087%,52.513964427635074%)",
+			ExpectedSpotColor:       "hsl(210,40.28361354023218%,42.68841225421056%)",
+			ExpectedData: "2211112201022010011001100011110000000000012112100012210010100101",
+		},
+		{
+			Address: ethaddr.ParseStringElsePanic("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"),
+			Size: 8,
+			ExpectedColor:           "hsl(40,95.56087048724294%,53.07253532810137%)",
+			ExpectedBackgroundColor: "hsl(62,84.17102983221412%,56.51313749840483%)",
+			ExpectedSpotColor:       "hsl(244,90.81825148314238%,69.22265975736082%)",
+			ExpectedData: "1000000100000000110000111211112101011010021221200200002010211201",
+		},
+		{
+			Address: ethaddr.Zero(),
+			Size: 8,
+			ExpectedColor:           "hsl(354,66.81960879825056%,56.21398128569126%)",
+			ExpectedBackgroundColor: "hsl(213,65.0781786441803%,49.646922980900854%)",
+			ExpectedSpotColor:       "hsl(327,41.4509454369545%,63.29597851727158%)",
+			ExpectedData: "0010010010000001011001102111111210122101100110012000000200211200",
+		},
+		{
+			Address: ethaddr.ParseStringElsePanic("0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"),
+			Size: 5,
+			ExpectedColor:           "hsl(215,84.57278878428042%,22.077343030832708%)",
+			ExpectedBackgroundColor: "hsl(310,48.36197377182543%,37.81167025445029%)",
+			ExpectedSpotColor:       "hsl(267,72.57982996292412%,49.60557169979438%)",
+			ExpectedData: "1020100100110111121101010",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		blockie, err := blockies.GenerateSize(test.Address, test.Size)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("ADDRESS: %s", test.Address)
+			continue
+		}
+
+		for _, color := range []struct{
+			Name     string
+			Expected string
+			Actual   string
+		}{
+			{Name: "color",            Expected: test.ExpectedColor,           Actual: blockie.Color.String()},
+			{Name: "background-color", Expected: test.ExpectedBackgroundColor, Actual: blockie.BackgroundColor.String()},
+			{Name: "spot-color",       Expected: test.ExpectedSpotColor,       Actual: blockie.SpotColor.String()},
+		} {
+			if color.Expected != color.Actual {
+				t.Errorf("For test #%d, the actual %s is not what was expected.", testNumber, color.Name)
+				t.Logf("EXPECTED: %s", color.Expected)
+				t.Logf("ACTUAL:   %s", color.Actual)
+				t.Logf("ADDRESS: %s", test.Address)
+				continue
+			}
+		}
+
+		{
+			var data []byte = make([]byte, len(blockie.Data))
+			for i, cell := range blockie.Data {
+				data[i] = '0' + cell
+			}
+
+			expected := test.ExpectedData
+			actual := string(data)
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual data is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %s", expected)
+				t.Logf("ACTUAL:   %s", actual)
+				t.Logf("ADDRESS: %s", test.Address)
+				continue
+			}
+		}
+	}
+}
+
+func TestGenerate_nothing(t *testing.T) {
+
+	_, err := blockies.Generate(ethaddr.Nothing())
+	if nil == err {
+		t.Errorf("Expected an error but did not actually get one.")
+		return
+	}
+
+	{
+		expected := "blockies: eth-address is nothing"
+		actual := err.Error()
+
+		if expected != actual {
+			t.Errorf("The actual error is not what was expected.")
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			return
+		}
+	}
+}
+
+func TestBlockie_PNG(t *testing.T) {
+
+	blockie, err := blockies.Generate(ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	pngBytes, err := blockie.PNG(blockies.DefaultScale)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	img, err := png.Decode(bytes.NewReader(pngBytes))
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	{
+		expected := blockies.DefaultSize * blockies.DefaultScale
+		actual := img.Bounds().Dx()
+
+		if expected != actual {
+			t.Errorf("The actual width is not what was expected.")
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			return
+		}
+	}
+
+	// The first cell is a spot-cell (see TestGenerateSize), and the third cell is a foreground-cell.
+	for _, pixel := range []struct{
+		X, Y int
+		Expected blockies.HSL
+	}{
+		{X: 0, Y: 0, Expected: blockie.SpotColor},
+		{X: 3, Y: 3, Expected: blockie.SpotColor},
+		{X: 8, Y: 0, Expected: blockie.Color},
+		{X: 0, Y: 4, Expected: blockie.BackgroundColor},
+	} {
+		er, eg, eb, _ := pixel.Expected.RGBA()
+		ar, ag, ab, _ := img.At(pixel.X, pixel.Y).RGBA()
+
+		if er != ar || eg != ag || eb != ab {
+			t.Errorf("The actual color of pixel (%d,%d) is not what was expected.", pixel.X, pixel.Y)
+			t.Logf("EXPECTED: (%d,%d,%d)", er, eg, eb)
+			t.Logf("ACTUAL:   (%d,%d,%d)", ar, ag, ab)
+			continue
+		}
+	}
+}
+
+func TestBlockie_SVG(t *testing.T) {
+
+	blockie, err := blockies.GenerateSize(ethaddr.ParseStringElsePanic("0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"), 5)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	svg, err := blockie.SVG(4)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	{
+		expected := `<svg xmlns="http://www.w3.org/2000/svg" width="20" height="20" viewBox="0 0 5 5" shape-rendering="crispEdges">` +
+			`<rect width="5" height="5" fill="hsl(310,48.36197377182543%,37.81167025445029%)"/>`
+		actual := svg
+
+		if !strings.HasPrefix(actual, expected) {
+			t.Errorf("The actual SVG does not start with what was expected.")
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			return
+		}
+	}
+
+	// 15 non-background cells in "1020100100110111121101010".
+	{
+		expected := 1 + 15
+		actual := strings.Count(svg, "<rect")
+
+		if expected != actual {
+			t.Errorf("The actual number of rects is not what was expected.")
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			return
+		}
+	}
+}
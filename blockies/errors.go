@@ -0,0 +1,11 @@
+package blockies
+
+import (
+	"github.com/reiver/go-erorr"
+)
+
+const (
+	errBadSize  = erorr.Error("blockies: size must be positive")
+	errBadScale = erorr.Error("blockies: scale must be positive")
+	errNothing  = erorr.Error("blockies: eth-address is nothing")
+)
@@ -0,0 +1,55 @@
+package blockies
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+)
+
+// HSL is a color in the (CSS) hue-saturation-lightness color-model.
+//
+// H is in degrees (0 to 360).
+// S and L are percentages (0 to 100).
+type HSL struct {
+	H float64
+	S float64
+	L float64
+}
+
+var _ color.Color = HSL{}
+
+// RGB converts the color to (8-bit) red, green, blue, using the CSS conversion algorithm.
+func (receiver HSL) RGB() (r uint8, g uint8, b uint8) {
+	var s float64 = receiver.S / 100
+	var l float64 = receiver.L / 100
+
+	f := func(n float64) uint8 {
+		var k float64 = math.Mod(n + receiver.H/30, 12)
+		var a float64 = s * math.Min(l, 1-l)
+		var v float64 = l - a*math.Max(-1, math.Min(math.Min(k-3, 9-k), 1))
+
+		return uint8(math.Round(v * 255))
+	}
+
+	return f(0), f(8), f(4)
+}
+
+// RGBA makes it so HSL implements color.Color.
+func (receiver HSL) RGBA() (r, g, b, a uint32) {
+	r8, g8, b8 := receiver.RGB()
+	return color.RGBA{R: r8, G: g8, B: b8, A: 0xFF}.RGBA()
+}
+
+// String returns the CSS form of the color, exactly as the (JavaScript) ethereum-blockies library would write it.
+//
+// For example:
+//
+//	"hsl(213,53.21462915185839%,51.73828124627471%)"
+func (receiver HSL) String() string {
+	return "hsl(" + formatNumber(receiver.H) + "," + formatNumber(receiver.S) + "%," + formatNumber(receiver.L) + "%)"
+}
+
+// formatNumber formats the number the same way JavaScript would (for the range of numbers used here).
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,55 @@
+package blockies_test
+
+import (
+	"testing"
+
+	"github.com/reiver/go-ethaddr/blockies"
+)
+
+func TestHSL_RGB(t *testing.T) {
+
+	tests := []struct{
+		HSL blockies.HSL
+		ExpectedR uint8
+		ExpectedG uint8
+		ExpectedB uint8
+	}{
+		{
+			HSL: blockies.HSL{H:0, S:100, L:50},
+			ExpectedR: 255, ExpectedG: 0, ExpectedB: 0,
+		},
+		{
+			HSL: blockies.HSL{H:120, S:100, L:25},
+			ExpectedR: 0, ExpectedG: 128, ExpectedB: 0,
+		},
+		{
+			HSL: blockies.HSL{H:240, S:100, L:50},
+			ExpectedR: 0, ExpectedG: 0, ExpectedB: 255,
+		},
+		{
+			HSL: blockies.HSL{H:0, S:0, L:50},
+			ExpectedR: 128, ExpectedG: 128, ExpectedB: 128,
+		},
+		{
+			HSL: blockies.HSL{H:60, S:100, L:100},
+			ExpectedR: 255, ExpectedG: 255, ExpectedB: 255,
+		},
+		{
+			HSL: blockies.HSL{H:300, S:50, L:0},
+			ExpectedR: 0, ExpectedG: 0, ExpectedB: 0,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		r, g, b := test.HSL.RGB()
+
+		if test.ExpectedR != r || test.ExpectedG != g || test.ExpectedB != b {
+			t.Errorf("For test #%d, the actual RGB is not what was expected.", testNumber)
+			t.Logf("EXPECTED: (%d,%d,%d)", test.ExpectedR, test.ExpectedG, test.ExpectedB)
+			t.Logf("ACTUAL:   (%d,%d,%d)", r, g, b)
+			t.Logf("HSL: %s", test.HSL)
+			continue
+		}
+	}
+}
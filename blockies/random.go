@@ -0,0 +1,39 @@
+package blockies
+
+// random is the xorshift pseudo-random number generator that the (JavaScript) ethereum-blockies library uses.
+//
+// The arithmetic here mimics the JavaScript (including its int32 wrap-around), so that the output is the same.
+type random struct {
+	seed [4]int32
+}
+
+func newRandom(seed string) *random {
+	var r random
+
+	for i:=0; i<len(seed); i++ {
+		var s *int32 = &r.seed[i%4]
+		*s = ((*s << 5) - *s) + int32(seed[i])
+	}
+
+	return &r
+}
+
+// next returns a pseudo-random number in the range [0, 1).
+func (receiver *random) next() float64 {
+	var t int32 = receiver.seed[0] ^ (receiver.seed[0] << 11)
+
+	receiver.seed[0] = receiver.seed[1]
+	receiver.seed[1] = receiver.seed[2]
+	receiver.seed[2] = receiver.seed[3]
+	receiver.seed[3] = receiver.seed[3] ^ (receiver.seed[3] >> 19) ^ t ^ (t >> 8)
+
+	return float64(uint32(receiver.seed[3])) / float64(uint32(1)<<31)
+}
+
+func (receiver *random) color() HSL {
+	var h float64 = float64(int(receiver.next() * 360))
+	var s float64 = (receiver.next() * 60) + 40
+	var l float64 = (receiver.next() + receiver.next() + receiver.next() + receiver.next()) * 25
+
+	return HSL{H: h, S: s, L: l}
+}
@@ -0,0 +1,136 @@
+// Package main is the C interface to package ethaddr.
+//
+// It is built as a C shared library with:
+//
+//	go build -buildmode=c-shared -o libethaddr.so ./capi
+//
+// And is used through the (stable) header file "ethaddr.h" in this directory.
+package main
+
+/*
+#include <stddef.h>
+#include <stdint.h>
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+// These must match the ETHADDR_* error codes in "ethaddr.h".
+const (
+	codeOK             C.int = 0
+	codeNullPointer    C.int = -1
+	codeBufferTooSmall C.int = -2
+	codeMissingPrefix  C.int = -3
+	codeBadLength      C.int = -4
+	codeBadHex         C.int = -5
+	codeBadChecksum    C.int = -6
+)
+
+const eip55BufferSize = 42 + 1
+
+// maxTextLen is the longest hexadecimal-literal that could be an eth-address ("0x" followed by 40 hexadecimal-symbols).
+//
+// Anything longer is rejected before it is copied, so that the C size_t length never has to be narrowed (to the C int that C.GoBytes takes).
+const maxTextLen = 2 + ethaddr.AddressLength*2
+
+func main() {}
+
+// goBytes returns a copy of the C bytes text[0:length].
+//
+// 'length' must be at most maxTextLen.
+func goBytes(text *C.char, length C.size_t) []byte {
+	if 0 == length {
+		return []byte{}
+	}
+	return C.GoBytes(unsafe.Pointer(text), C.int(length))
+}
+
+// parse calls ethaddr.Parse, and maps any error to an error code.
+//
+// ethaddr.Parse decides whether 'text' is valid.
+// The error code is only a classification of why it is not.
+func parse(text []byte) (ethaddr.Address, C.int) {
+	address, err := ethaddr.Parse(text)
+	if nil == err {
+		return address, codeOK
+	}
+
+	switch {
+	case errors.Is(err, ethaddr.ErrMissingPrefix):
+		return ethaddr.Nothing(), codeMissingPrefix
+	case errors.Is(err, ethaddr.ErrBadLength):
+		return ethaddr.Nothing(), codeBadLength
+	default:
+		return ethaddr.Nothing(), codeBadHex
+	}
+}
+
+//export ethaddr_parse
+func ethaddr_parse(text *C.char, textLen C.size_t, out *C.uint8_t) C.int {
+	if (nil == text && 0 != textLen) || nil == out {
+		return codeNullPointer
+	}
+	if maxTextLen < textLen {
+		return codeBadLength
+	}
+
+	address, code := parse(goBytes(text, textLen))
+	if codeOK != code {
+		return code
+	}
+
+	value, _ := address.Get()
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(out)), len(value)), value[:])
+	return codeOK
+}
+
+//export ethaddr_eip55
+func ethaddr_eip55(address *C.uint8_t, buf *C.char, bufLen C.size_t) C.int {
+	if nil == address || nil == buf {
+		return codeNullPointer
+	}
+	if bufLen < eip55BufferSize {
+		return codeBufferTooSmall
+	}
+
+	var value [ethaddr.AddressLength]byte
+	copy(value[:], unsafe.Slice((*byte)(unsafe.Pointer(address)), len(value)))
+
+	var dst []byte = unsafe.Slice((*byte)(unsafe.Pointer(buf)), eip55BufferSize)
+	copy(dst, ethaddr.Something(value).EIP55())
+	dst[eip55BufferSize-1] = 0
+	return codeOK
+}
+
+//export ethaddr_is_valid
+func ethaddr_is_valid(text *C.char, textLen C.size_t) C.int {
+	if nil == text && 0 != textLen {
+		return codeNullPointer
+	}
+	if maxTextLen < textLen {
+		return codeBadLength
+	}
+
+	var data []byte = goBytes(text, textLen)
+
+	_, code := parse(data)
+	if codeOK != code {
+		return code
+	}
+
+	// ethaddr.Parse also accepts 39 hexadecimal-symbols, but that is (most likely) a dropped hexadecimal-symbol.
+	if maxTextLen != textLen {
+		return codeBadLength
+	}
+
+	if !ethaddr.HasValidChecksum(string(data)) {
+		return codeBadChecksum
+	}
+
+	return codeOK
+}
@@ -0,0 +1,40 @@
+//go:build linux
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestC builds the C shared library, and then compiles and runs the C test program (testdata/ethaddr_test.c) against it.
+func TestC(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	cc, err := exec.LookPath("cc")
+	if nil != err {
+		t.Skip("skipping since there is no C compiler")
+	}
+
+	var dir string = t.TempDir()
+
+	run := func(name string, args ...string) {
+		t.Helper()
+
+		var cmd *exec.Cmd = exec.Command(name, args...)
+		cmd.Env = append(os.Environ(), "LD_LIBRARY_PATH="+dir)
+
+		output, err := cmd.CombinedOutput()
+		if nil != err {
+			t.Fatalf("%s %v: %s\n%s", name, args, err, output)
+		}
+	}
+
+	run("go", "build", "-buildmode=c-shared", "-o", filepath.Join(dir, "libethaddr.so"), ".")
+	run(cc, "-Wall", "-Werror", "-I", ".", "-o", filepath.Join(dir, "ethaddr_test"), filepath.Join("testdata", "ethaddr_test.c"), "-L", dir, "-lethaddr")
+	run(filepath.Join(dir, "ethaddr_test"))
+}
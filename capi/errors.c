@@ -0,0 +1,22 @@
+#include "ethaddr.h"
+
+const char* ethaddr_error_string(int code) {
+	switch (code) {
+	case ETHADDR_OK:
+		return "ok";
+	case ETHADDR_ERR_NULL_POINTER:
+		return "null pointer";
+	case ETHADDR_ERR_BUFFER_TOO_SMALL:
+		return "buffer too small";
+	case ETHADDR_ERR_MISSING_PREFIX:
+		return "missing prefix for hexadecimal-literal (i.e., \"0x\")";
+	case ETHADDR_ERR_BAD_LENGTH:
+		return "eth-address has the wrong length";
+	case ETHADDR_ERR_BAD_HEX:
+		return "eth-address contains a byte that is not a hexadecimal symbol";
+	case ETHADDR_ERR_BAD_CHECKSUM:
+		return "bad EIP-55 / ERC-55 checksum";
+	default:
+		return "unknown error";
+	}
+}
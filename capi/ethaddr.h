@@ -0,0 +1,83 @@
+/*
+ * ethaddr.h — the C interface to package ethaddr (github.com/reiver/go-ethaddr).
+ *
+ * Build the shared library with:
+ *
+ *	go build -buildmode=c-shared -o libethaddr.so ./capi
+ *
+ * All buffers are owned by the caller. The library never allocates memory that the caller must free.
+ *
+ * This header is stable. Do not use the header that "go build -buildmode=c-shared" generates.
+ */
+#ifndef ETHADDR_H
+#define ETHADDR_H
+
+#include <stddef.h>
+#include <stdint.h>
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+/* The number of bytes in an eth-address. */
+#define ETHADDR_LENGTH 20
+
+/* The number of bytes in an EIP-55 / ERC-55 encoded eth-address — including the "0x" prefix, but NOT including the NUL terminator. */
+#define ETHADDR_EIP55_LENGTH 42
+
+/* The size of a buffer that can hold an EIP-55 / ERC-55 encoded eth-address — including the NUL terminator. */
+#define ETHADDR_EIP55_BUFFER_SIZE (ETHADDR_EIP55_LENGTH + 1)
+
+/* Error codes. Zero is success. Errors are negative. */
+#define ETHADDR_OK                    0
+#define ETHADDR_ERR_NULL_POINTER     -1 /* a pointer parameter was NULL */
+#define ETHADDR_ERR_BUFFER_TOO_SMALL -2 /* the caller's buffer is too small */
+#define ETHADDR_ERR_MISSING_PREFIX   -3 /* the hexadecimal-literal does not start with "0x" */
+#define ETHADDR_ERR_BAD_LENGTH       -4 /* the hexadecimal-literal is not 42 (or 41) bytes long */
+#define ETHADDR_ERR_BAD_HEX          -5 /* the hexadecimal-literal contains a byte that is not a hexadecimal symbol */
+#define ETHADDR_ERR_BAD_CHECKSUM     -6 /* the (mixed-case) hexadecimal-literal has a bad EIP-55 / ERC-55 checksum */
+
+/*
+ * ethaddr_parse parses the ("0x" prefixed) hexadecimal-literal in text[0..text_len) into out[0..20).
+ *
+ * It accepts exactly what ethaddr.Parse accepts. (So it does NOT check the EIP-55 / ERC-55 checksum.)
+ *
+ * Returns ETHADDR_OK, or one of ETHADDR_ERR_NULL_POINTER, ETHADDR_ERR_MISSING_PREFIX, ETHADDR_ERR_BAD_LENGTH, or ETHADDR_ERR_BAD_HEX.
+ * If text_len is greater than 42, then ETHADDR_ERR_BAD_LENGTH is returned (without reading text).
+ * If there is an error, then out is not modified.
+ */
+int ethaddr_parse(const char* text, size_t text_len, uint8_t out[ETHADDR_LENGTH]);
+
+/*
+ * ethaddr_eip55 writes the EIP-55 / ERC-55 encoding of address[0..20), followed by a NUL terminator, into buf[0..buf_len).
+ *
+ * buf_len must be at least ETHADDR_EIP55_BUFFER_SIZE.
+ *
+ * Returns ETHADDR_OK, ETHADDR_ERR_NULL_POINTER, or ETHADDR_ERR_BUFFER_TOO_SMALL.
+ * If there is an error, then buf is not modified.
+ */
+int ethaddr_eip55(const uint8_t address[ETHADDR_LENGTH], char* buf, size_t buf_len);
+
+/*
+ * ethaddr_is_valid checks the hexadecimal-literal in text[0..text_len).
+ *
+ * It is valid if ethaddr_parse accepts it, AND it has exactly 40 hexadecimal symbols, AND (if it is mixed-case) it has a valid EIP-55 / ERC-55 checksum.
+ * (ethaddr_parse also accepts 39 hexadecimal symbols, but ethaddr_is_valid returns ETHADDR_ERR_BAD_LENGTH for that, since a hexadecimal symbol was most likely dropped.)
+ * If text_len is greater than 42, then ETHADDR_ERR_BAD_LENGTH is returned (without reading text).
+ *
+ * Returns ETHADDR_OK if it is valid, otherwise an error code (including ETHADDR_ERR_BAD_CHECKSUM).
+ */
+int ethaddr_is_valid(const char* text, size_t text_len);
+
+/*
+ * ethaddr_error_string returns a (static, NUL terminated) description of an error code.
+ *
+ * The caller must NOT free it.
+ */
+const char* ethaddr_error_string(int code);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif /* ETHADDR_H */
@@ -0,0 +1,68 @@
+/*
+ * This is a test program for the C interface to package ethaddr.
+ *
+ * It is compiled and run by TestC (in capi_test.go).
+ */
+#include <stdio.h>
+#include <string.h>
+
+#include "ethaddr.h"
+
+static int failures = 0;
+
+#define EXPECT(condition) do { \
+	if (!(condition)) { \
+		fprintf(stderr, "%s:%d: expected: %s\n", __FILE__, __LINE__, #condition); \
+		failures++; \
+	} \
+} while (0)
+
+int main(void) {
+	const char* lower   = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed";
+	const char* eip55   = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed";
+	const char* badsum  = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD";
+	const uint8_t expected[ETHADDR_LENGTH] = {0x5a,0xAe,0xb6,0x05,0x3F,0x3E,0x94,0xC9,0xb9,0xA0,0x9f,0x33,0x66,0x94,0x35,0xE7,0xEf,0x1B,0xeA,0xed};
+
+	uint8_t address[ETHADDR_LENGTH];
+	char buf[ETHADDR_EIP55_BUFFER_SIZE];
+
+	/* ethaddr_parse */
+	EXPECT(ETHADDR_OK == ethaddr_parse(lower, strlen(lower), address));
+	EXPECT(0 == memcmp(expected, address, ETHADDR_LENGTH));
+	EXPECT(ETHADDR_OK == ethaddr_parse(badsum, strlen(badsum), address));
+	EXPECT(ETHADDR_ERR_MISSING_PREFIX == ethaddr_parse(lower+2, strlen(lower+2), address));
+	EXPECT(ETHADDR_ERR_BAD_LENGTH == ethaddr_parse(lower, 10, address));
+	EXPECT(ETHADDR_ERR_BAD_LENGTH == ethaddr_parse(lower, (size_t)0x80000000, address));
+	EXPECT(ETHADDR_ERR_BAD_LENGTH == ethaddr_parse(lower, ((size_t)1 << 32) + 42, address));
+	EXPECT(ETHADDR_ERR_BAD_HEX == ethaddr_parse("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaeg", 42, address));
+	EXPECT(ETHADDR_ERR_NULL_POINTER == ethaddr_parse(lower, strlen(lower), NULL));
+	EXPECT(ETHADDR_ERR_MISSING_PREFIX == ethaddr_parse(NULL, 0, address));
+
+	/* ethaddr_eip55 */
+	memset(buf, 'X', sizeof(buf));
+	EXPECT(ETHADDR_OK == ethaddr_eip55(expected, buf, sizeof(buf)));
+	EXPECT(0 == strcmp(eip55, buf));
+	EXPECT(ETHADDR_ERR_BUFFER_TOO_SMALL == ethaddr_eip55(expected, buf, ETHADDR_EIP55_LENGTH));
+	EXPECT(ETHADDR_ERR_NULL_POINTER == ethaddr_eip55(NULL, buf, sizeof(buf)));
+
+	/* ethaddr_is_valid */
+	EXPECT(ETHADDR_OK == ethaddr_is_valid(lower, strlen(lower)));
+	EXPECT(ETHADDR_OK == ethaddr_is_valid(eip55, strlen(eip55)));
+	EXPECT(ETHADDR_ERR_BAD_CHECKSUM == ethaddr_is_valid(badsum, strlen(badsum)));
+	EXPECT(ETHADDR_ERR_BAD_LENGTH == ethaddr_is_valid("0x", 2));
+	EXPECT(ETHADDR_ERR_BAD_LENGTH == ethaddr_is_valid(lower, strlen(lower)-1));
+	EXPECT(ETHADDR_ERR_BAD_LENGTH == ethaddr_is_valid(lower, (size_t)0x80000000));
+	EXPECT(ETHADDR_ERR_BAD_LENGTH == ethaddr_is_valid(lower, ((size_t)1 << 32) + 42));
+
+	/* ethaddr_error_string */
+	EXPECT(0 == strcmp("bad EIP-55 / ERC-55 checksum", ethaddr_error_string(ETHADDR_ERR_BAD_CHECKSUM)));
+	EXPECT(0 == strcmp("unknown error", ethaddr_error_string(12345)));
+
+	if (0 != failures) {
+		fprintf(stderr, "%d failure(s)\n", failures);
+		return 1;
+	}
+
+	printf("ok\n");
+	return 0;
+}
@@ -0,0 +1,183 @@
+package ethaddr
+
+import (
+	"github.com/reiver/go-erorr"
+)
+
+// CBOR (RFC 8949) major-types and simple-values used here.
+const (
+	cborMajorTypeByteString byte = 2
+	cborMajorTypeTextString byte = 3
+
+	cborNull      byte = 0xF6
+	cborUndefined byte = 0xF7
+)
+
+// AppendCBOR appends the CBOR (RFC 8949) encoding of the eth-address to 'dst'.
+//
+// Something() is encoded as a CBOR byte-string of length 20.
+// For example:
+//
+//	// 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed
+//	[]byte{0x54, 0x5a,0xAe,0xb6,0x05,0x3F,0x3E,0x94,0xC9,0xb9,0xA0,0x9f,0x33,0x66,0x94,0x35,0xE7,0xEf,0x1B,0xeA,0xed}
+//
+// Nothing() is encoded as CBOR null:
+//
+//	[]byte{0xF6}
+func (receiver Address) AppendCBOR(dst []byte) []byte {
+	value, something := receiver.optional.Get()
+	if !something {
+		return append(dst, cborNull)
+	}
+
+	dst = appendCBORHeader(dst, cborMajorTypeByteString, AddressLength)
+	return append(dst, value[:]...)
+}
+
+// AppendCBORText is similar to AppendCBOR, except that Something() is encoded as a CBOR text-string of the EIP-55 / ERC-55 encoded hexadecimal-literal.
+// For example:
+//
+//	// 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed
+//	append([]byte{0x78, 0x2A}, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"...)
+//
+// Nothing() is (still) encoded as CBOR null.
+func (receiver Address) AppendCBORText(dst []byte) []byte {
+	if receiver.IsNothing() {
+		return append(dst, cborNull)
+	}
+
+	var text string = receiver.EIP55()
+
+	dst = appendCBORHeader(dst, cborMajorTypeTextString, uint64(len(text)))
+	return append(dst, text...)
+}
+
+// MarshalCBOR returns the CBOR encoding of the eth-address.
+//
+// See AppendCBOR.
+func (receiver Address) MarshalCBOR() ([]byte, error) {
+	return receiver.AppendCBOR(nil), nil
+}
+
+// UnmarshalCBOR sets the receiver to the eth-address encoded as CBOR in 'data'.
+//
+// See DecodeCBOR for what is accepted.
+// Unlike DecodeCBOR, UnmarshalCBOR returns an error if there is anything after the CBOR data-item.
+func (receiver *Address) UnmarshalCBOR(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	address, rest, err := DecodeCBOR(data)
+	if nil != err {
+		return err
+	}
+	if 0 < len(rest) {
+		return erorr.Errorf("ethaddr: %d unexpected trailing byte(s) after CBOR data-item", len(rest))
+	}
+
+	*receiver = address
+	return nil
+}
+
+// DecodeCBOR decodes the CBOR (RFC 8949) data-item at the beginning of 'data' into an eth-address, and returns whatever comes after it.
+//
+// DecodeCBOR accepts:
+//
+//	• a (definite-length) byte-string of length 20 — see AppendCBOR,
+//	• a (definite-length) text-string of a hexadecimal-literal — see AppendCBORText,
+//	• null, and undefined — which both become Nothing().
+//
+// Note that the text-string is NOT required to be EIP-55 / ERC-55 encoded (in the same way that Parse does not require it).
+func DecodeCBOR(data []byte) (Address, []byte, error) {
+	if len(data) < 1 {
+		return Nothing(), data, errEmptyData
+	}
+
+	switch data[0] {
+	case cborNull, cborUndefined:
+		return Nothing(), data[1:], nil
+	}
+
+	switch majorType := data[0] >> 5; majorType {
+	case cborMajorTypeByteString, cborMajorTypeTextString:
+		// these are OK.
+	default:
+		return Nothing(), data, erorr.Errorf("ethaddr: expected CBOR byte-string, text-string, or null, but actually got CBOR major-type %d", majorType)
+	}
+
+	majorType, length, rest, err := decodeCBORHeader(data)
+	if nil != err {
+		return Nothing(), data, err
+	}
+
+	if uint64(len(rest)) < length {
+		return Nothing(), data, erorr.Errorf("ethaddr: CBOR data-item says it has %d bytes, but only %d byte(s) remain", length, len(rest))
+	}
+	var content []byte = rest[:length]
+	rest = rest[length:]
+
+	var address Address
+	if cborMajorTypeByteString == majorType {
+		err = address.UnmarshalBinary(content)
+	} else {
+		err = address.UnmarshalText(content)
+	}
+	if nil != err {
+		return Nothing(), data, err
+	}
+
+	return address, rest, nil
+}
+
+func appendCBORHeader(dst []byte, majorType byte, length uint64) []byte {
+	var mt byte = majorType << 5
+
+	switch {
+	case length < 24:
+		return append(dst, mt|byte(length))
+	case length <= 0xFF:
+		return append(dst, mt|24, byte(length))
+	case length <= 0xFFFF:
+		return append(dst, mt|25, byte(length>>8), byte(length))
+	case length <= 0xFFFFFFFF:
+		return append(dst, mt|26, byte(length>>24), byte(length>>16), byte(length>>8), byte(length))
+	default:
+		return append(dst, mt|27, byte(length>>56), byte(length>>48), byte(length>>40), byte(length>>32), byte(length>>24), byte(length>>16), byte(length>>8), byte(length))
+	}
+}
+
+// decodeCBORHeader decodes the initial-byte (and any additional length bytes) of a CBOR data-item.
+func decodeCBORHeader(data []byte) (majorType byte, length uint64, rest []byte, err error) {
+	majorType = data[0] >> 5
+	var info byte = data[0] & 0x1F
+	rest = data[1:]
+
+	var size int
+	switch {
+	case info < 24:
+		return majorType, uint64(info), rest, nil
+	case 24 == info:
+		size = 1
+	case 25 == info:
+		size = 2
+	case 26 == info:
+		size = 4
+	case 27 == info:
+		size = 8
+	case 31 == info:
+		return majorType, 0, data, errCBORIndefiniteLength
+	default:
+		return majorType, 0, data, erorr.Errorf("ethaddr: malformed CBOR initial-byte (0x%02X)", data[0])
+	}
+
+	if len(rest) < size {
+		return majorType, 0, data, errCBORTruncated
+	}
+
+	for _, b := range rest[:size] {
+		length = (length << 8) | uint64(b)
+	}
+
+	return majorType, length, rest[size:], nil
+}
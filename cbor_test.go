@@ -0,0 +1,223 @@
+package ethaddr_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestAddress_AppendCBOR(t *testing.T) {
+
+	tests := []struct{
+		Address ethaddr.Address
+		Expected []byte
+		ExpectedText []byte
+	}{
+		{
+			Address: ethaddr.Nothing(),
+			Expected:     []byte{0xF6},
+			ExpectedText: []byte{0xF6},
+		},
+		{
+			Address: ethaddr.Something( [20]byte{0x5a,0xAe,0xb6,0x05,0x3F,0x3E,0x94,0xC9,0xb9,0xA0,0x9f,0x33,0x66,0x94,0x35,0xE7,0xEf,0x1B,0xeA,0xed} ),
+			Expected:     []byte{0x54, 0x5a,0xAe,0xb6,0x05,0x3F,0x3E,0x94,0xC9,0xb9,0xA0,0x9f,0x33,0x66,0x94,0x35,0xE7,0xEf,0x1B,0xeA,0xed},
+			ExpectedText: append([]byte{0x78, 0x2A}, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"...),
+		},
+		{
+			Address: ethaddr.Zero(),
+			Expected:     []byte{0x54, 0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00},
+			ExpectedText: append([]byte{0x78, 0x2A}, "0x0000000000000000000000000000000000000000"...),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		{
+			actual := test.Address.AppendCBOR([]byte{0x82})
+			expected := append([]byte{0x82}, test.Expected...)
+
+			if !bytes.Equal(expected, actual) {
+				t.Errorf("For test #%d, the actual CBOR is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %X", expected)
+				t.Logf("ACTUAL:   %X", actual)
+				t.Logf("ADDRESS: %#v", test.Address)
+				continue
+			}
+		}
+
+		{
+			actual := test.Address.AppendCBORText(nil)
+			expected := test.ExpectedText
+
+			if !bytes.Equal(expected, actual) {
+				t.Errorf("For test #%d, the actual CBOR text is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %X", expected)
+				t.Logf("ACTUAL:   %X", actual)
+				t.Logf("ADDRESS: %#v", test.Address)
+				continue
+			}
+		}
+
+		for _, encoded := range [][]byte{test.Expected, test.ExpectedText} {
+			var actual ethaddr.Address = ethaddr.Dead()
+
+			err := actual.UnmarshalCBOR(encoded)
+			if nil != err {
+				t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+				t.Logf("ERROR: (%T) %s", err, err)
+				t.Logf("CBOR: %X", encoded)
+				continue
+			}
+
+			if expected := test.Address; expected != actual {
+				t.Errorf("For test #%d, the actual unmarshaled value is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %#v", expected)
+				t.Logf("ACTUAL:   %#v", actual)
+				t.Logf("CBOR: %X", encoded)
+				continue
+			}
+		}
+	}
+}
+
+func TestDecodeCBOR(t *testing.T) {
+
+	tests := []struct{
+		CBOR []byte
+		Expected ethaddr.Address
+		ExpectedRest []byte
+	}{
+		{
+			CBOR: []byte{0xF6},
+			Expected: ethaddr.Nothing(),
+		},
+		{
+			CBOR: []byte{0xF7, 0x01},
+			Expected: ethaddr.Nothing(),
+			ExpectedRest: []byte{0x01},
+		},
+		{
+			// non-preferred (but valid) length encoding.
+			CBOR: []byte{0x58, 0x14, 0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0xdE,0xaD, 0xF6},
+			Expected: ethaddr.Dead(),
+			ExpectedRest: []byte{0xF6},
+		},
+		{
+			// lower-case text.
+			CBOR: append([]byte{0x78, 0x2A}, "0x000000000000000000000000000000000000dead"...),
+			Expected: ethaddr.Dead(),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, rest, err := ethaddr.DecodeCBOR(test.CBOR)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("CBOR: %X", test.CBOR)
+			continue
+		}
+
+		if expected := test.Expected; expected != actual {
+			t.Errorf("For test #%d, the actual decoded value is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual)
+			t.Logf("CBOR: %X", test.CBOR)
+			continue
+		}
+
+		if expected := test.ExpectedRest; !bytes.Equal(expected, rest) {
+			t.Errorf("For test #%d, the actual rest is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %X", expected)
+			t.Logf("ACTUAL:   %X", rest)
+			t.Logf("CBOR: %X", test.CBOR)
+			continue
+		}
+	}
+}
+
+// Most of these are the examples from RFC 8949 Appendix A — they are valid CBOR, but not eth-addresses.
+func TestDecodeCBOR_fail(t *testing.T) {
+
+	tests := []struct{
+		CBOR []byte
+		ExpectedError string
+	}{
+		{
+			CBOR: []byte{},
+			ExpectedError: "ethaddr: empty data",
+		},
+		{
+			// 0
+			CBOR: []byte{0x00},
+			ExpectedError: "ethaddr: expected CBOR byte-string, text-string, or null, but actually got CBOR major-type 0",
+		},
+		{
+			// h''
+			CBOR: []byte{0x40},
+			ExpectedError: "ethaddr: the actual length of the data parameter (0) is not what was expected (20)",
+		},
+		{
+			// h'01020304'
+			CBOR: []byte{0x44, 0x01, 0x02, 0x03, 0x04},
+			ExpectedError: "ethaddr: the actual length of the data parameter (4) is not what was expected (20)",
+		},
+		{
+			// "IETF"
+			CBOR: []byte{0x64, 0x49, 0x45, 0x54, 0x46},
+			ExpectedError: "ethaddr: missing prefix for hexadecimal-literal (i.e., \"0x\")",
+		},
+		{
+			// (_ h'0102', h'030405')
+			CBOR: []byte{0x5f, 0x42, 0x01, 0x02, 0x43, 0x03, 0x04, 0x05, 0xff},
+			ExpectedError: "ethaddr: indefinite-length CBOR data-item not supported",
+		},
+		{
+			// [1, 2, 3]
+			CBOR: []byte{0x83, 0x01, 0x02, 0x03},
+			ExpectedError: "ethaddr: expected CBOR byte-string, text-string, or null, but actually got CBOR major-type 4",
+		},
+		{
+			// true
+			CBOR: []byte{0xf5},
+			ExpectedError: "ethaddr: expected CBOR byte-string, text-string, or null, but actually got CBOR major-type 7",
+		},
+		{
+			CBOR: []byte{0x54, 0x00, 0x00},
+			ExpectedError: "ethaddr: CBOR data-item says it has 20 bytes, but only 2 byte(s) remain",
+		},
+		{
+			CBOR: []byte{0x5C},
+			ExpectedError: "ethaddr: malformed CBOR initial-byte (0x5C)",
+		},
+		{
+			CBOR: []byte{0x59, 0x00},
+			ExpectedError: "ethaddr: truncated CBOR data-item",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, _, err := ethaddr.DecodeCBOR(test.CBOR)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("CBOR: %X", test.CBOR)
+			continue
+		}
+
+		{
+			expected := test.ExpectedError
+			actual := err.Error()
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				t.Logf("CBOR: %X", test.CBOR)
+				continue
+			}
+		}
+	}
+}
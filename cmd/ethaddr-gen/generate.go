@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"strings"
+)
+
+// generateGo returns the Go source code declaring the entries.
+func generateGo(packageName string, manifestPath string, chain string, entries []entry) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	fmt.Fprintf(&buffer, "// Code generated by ethaddr-gen from %s. DO NOT EDIT.\n\n", filepath.Base(manifestPath))
+	if "" != chain {
+		fmt.Fprintf(&buffer, "// Chain: %s\n\n", oneLine(chain))
+	}
+	fmt.Fprintf(&buffer, "package %s\n\n", packageName)
+	fmt.Fprintf(&buffer, "import \"github.com/reiver/go-ethaddr\"\n\n")
+
+	fmt.Fprintf(&buffer, "var (\n")
+	for _, e := range entries {
+		if "" != e.Comment {
+			fmt.Fprintf(&buffer, "\t// %s is %s.\n", e.GoName, oneLine(e.Comment))
+		}
+
+		value, _ := e.Address.Get()
+
+		fmt.Fprintf(&buffer, "\t%s = ethaddr.Something([20]byte{", e.GoName)
+		for index, b := range value {
+			if 0 < index {
+				buffer.WriteString(", ")
+			}
+			fmt.Fprintf(&buffer, "%#02x", b)
+		}
+		fmt.Fprintf(&buffer, "}) // %s\n", e.Address.EIP55())
+	}
+	fmt.Fprintf(&buffer, ")\n")
+
+	return format.Source(buffer.Bytes())
+}
+
+// generateSolidity returns the Solidity source code declaring the entries.
+func generateSolidity(libraryName string, pragma string, manifestPath string, chain string, entries []entry) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	fmt.Fprintf(&buffer, "// SPDX-License-Identifier: UNLICENSED\n")
+	fmt.Fprintf(&buffer, "// Code generated by ethaddr-gen from %s. DO NOT EDIT.\n", filepath.Base(manifestPath))
+	if "" != chain {
+		fmt.Fprintf(&buffer, "// Chain: %s\n", oneLine(chain))
+	}
+	fmt.Fprintf(&buffer, "pragma solidity %s;\n\n", pragma)
+
+	fmt.Fprintf(&buffer, "library %s {\n", libraryName)
+	for index, e := range entries {
+		if 0 < index && "" != e.Comment {
+			buffer.WriteString("\n")
+		}
+		if "" != e.Comment {
+			fmt.Fprintf(&buffer, "    /// %s\n", oneLine(e.Comment))
+		}
+		fmt.Fprintf(&buffer, "    address internal constant %s = %s;\n", e.SolidityName, e.Address.EIP55())
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	return buffer.Bytes(), nil
+}
+
+// oneLine makes sure that 's' does not span more than one line, so that it can safely be put in a comment.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,146 @@
+// Command ethaddr-gen generates Go and Solidity source code, declaring eth-address constants, from a manifest.
+//
+// Usage:
+//
+//	ethaddr-gen --go=addresses.go --go-package=deployments --sol=Addresses.sol --sol-library=Addresses manifest.yaml
+//
+// The manifest can be JSON (if its name ends with ".json") or YAML (otherwise). For example:
+//
+//	chain: mainnet
+//	addresses:
+//	  - name: Treasury
+//	    address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+//	    comment: the DAO treasury
+//	  - name: FeeRecipient
+//	    address: "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359"
+//
+// Each name must start with a letter, and contain only letters, digits, and underscores.
+//
+// The Go source code declares, for example:
+//
+//	var Treasury = ethaddr.Something([20]byte{0x5a, 0xae, ...}) // 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed
+//
+// And the Solidity source code declares, for example:
+//
+//	address internal constant TREASURY = 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed;
+//
+// ethaddr-gen fails (and writes nothing) if any entry is invalid, if any name is used more than once, or if any eth-address is used more than once.
+//
+// It also fails if --go-package is not a Go identifier, if --sol-library is not a Solidity identifier, or if --sol-pragma is not a Solidity version constraint (such as "^0.8.0" or ">=0.8.0 <0.9.0").
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/token"
+	"io"
+	"os"
+	"regexp"
+)
+
+var (
+	solidityIdentifierPattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+	// For example: "^0.8.0", "0.8.19", ">=0.8.0 <0.9.0", "0.8.0 - 0.8.20", "^0.7.0 || ^0.8.0".
+	solidityPragmaPattern = regexp.MustCompile(`^` +
+		`(?:\^|~|>=|<=|>|<|=)?[0-9]+(?:\.(?:[0-9]+|x|\*)){0,2}` +
+		`(?:(?: +| +- +| *\|\| *)(?:\^|~|>=|<=|>|<|=)?[0-9]+(?:\.(?:[0-9]+|x|\*)){0,2})*` +
+		`$`)
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	var fs *flag.FlagSet = flag.NewFlagSet("ethaddr-gen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var goPath, goPackage, solPath, solLibrary, solPragma string
+	fs.StringVar(&goPath, "go", "", "the file to write the Go source code to (\"-\" for stdout)")
+	fs.StringVar(&goPackage, "go-package", "addresses", "the package name of the Go source code")
+	fs.StringVar(&solPath, "sol", "", "the file to write the Solidity source code to (\"-\" for stdout)")
+	fs.StringVar(&solLibrary, "sol-library", "Addresses", "the library name of the Solidity source code")
+	fs.StringVar(&solPragma, "sol-pragma", "^0.8.0", "the Solidity version pragma")
+
+	if err := fs.Parse(args); nil != err {
+		return 2
+	}
+	if 1 != fs.NArg() {
+		fmt.Fprintln(stderr, "ethaddr-gen: expected exactly one manifest file")
+		return 2
+	}
+	if "" == goPath && "" == solPath {
+		fmt.Fprintln(stderr, "ethaddr-gen: expected --go and/or --sol")
+		return 2
+	}
+
+	// These are put into the generated source code as-is, so they must not be able to break (or inject anything into) it.
+	if "" != goPath && (!token.IsIdentifier(goPackage) || "_" == goPackage) {
+		fmt.Fprintf(stderr, "ethaddr-gen: bad --go-package %q — it must be a Go identifier (that is not a keyword)\n", goPackage)
+		return 2
+	}
+	if "" != solPath && !solidityIdentifierPattern.MatchString(solLibrary) {
+		fmt.Fprintf(stderr, "ethaddr-gen: bad --sol-library %q — it must start with a letter, underscore, or dollar-sign, and contain only letters, digits, underscores, and dollar-signs\n", solLibrary)
+		return 2
+	}
+	if "" != solPath && !solidityPragmaPattern.MatchString(solPragma) {
+		fmt.Fprintf(stderr, "ethaddr-gen: bad --sol-pragma %q — it must be a Solidity version constraint (such as \"^0.8.0\" or \">=0.8.0 <0.9.0\")\n", solPragma)
+		return 2
+	}
+
+	var manifestPath string = fs.Arg(0)
+
+	manifest, err := loadManifest(manifestPath)
+	if nil != err {
+		fmt.Fprintf(stderr, "ethaddr-gen: %s: %s\n", manifestPath, err)
+		return 1
+	}
+
+	entries, problems := manifest.validate()
+	if 0 < len(problems) {
+		for _, problem := range problems {
+			fmt.Fprintf(stderr, "ethaddr-gen: %s: %s\n", manifestPath, problem)
+		}
+		return 1
+	}
+
+	// Everything is generated before anything is written, so that nothing is written if there is an error.
+	type output struct {
+		path string
+		code []byte
+	}
+	var outputs []output
+
+	if "" != goPath {
+		code, err := generateGo(goPackage, manifestPath, manifest.Chain, entries)
+		if nil != err {
+			fmt.Fprintf(stderr, "ethaddr-gen: problem generating Go source code: %s\n", err)
+			return 1
+		}
+		outputs = append(outputs, output{goPath, code})
+	}
+	if "" != solPath {
+		code, err := generateSolidity(solLibrary, solPragma, manifestPath, manifest.Chain, entries)
+		if nil != err {
+			fmt.Fprintf(stderr, "ethaddr-gen: problem generating Solidity source code: %s\n", err)
+			return 1
+		}
+		outputs = append(outputs, output{solPath, code})
+	}
+
+	for _, out := range outputs {
+		var err error
+		if "-" == out.path {
+			_, err = stdout.Write(out.code)
+		} else {
+			err = os.WriteFile(out.path, out.code, 0o644)
+		}
+		if nil != err {
+			fmt.Fprintf(stderr, "ethaddr-gen: problem writing %s: %s\n", out.path, err)
+			return 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+
+	var dir string = t.TempDir()
+
+	var manifestPath string = filepath.Join(dir, "mainnet.json")
+	{
+		const manifest =
+			`{"chain":"mainnet","addresses":[`+
+			`{"name":"Treasury","address":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed","comment":"the DAO treasury"},`+
+			`{"name":"feeRecipient","address":"0x000000000000000000000000000000000000dead"}`+
+			`]}`
+		if err := os.WriteFile(manifestPath, []byte(manifest), 0o600); nil != err {
+			t.Fatalf("could not write manifest: %s", err)
+		}
+	}
+
+	var goPath string = filepath.Join(dir, "addresses.go")
+	var solPath string = filepath.Join(dir, "Addresses.sol")
+
+	var stdout, stderr strings.Builder
+	exitCode := run([]string{"--go="+goPath, "--go-package=deployments", "--sol="+solPath, "--sol-library=Deployments", manifestPath}, &stdout, &stderr)
+	if expected, actual := 0, exitCode; expected != actual {
+		t.Errorf("The actual exit-code is not what was expected.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+		t.Logf("STDERR:\n%s", stderr.String())
+		return
+	}
+
+	{
+		const expected =
+			"// Code generated by ethaddr-gen from mainnet.json. DO NOT EDIT.\n"+
+			"\n"+
+			"// Chain: mainnet\n"+
+			"\n"+
+			"package deployments\n"+
+			"\n"+
+			"import \"github.com/reiver/go-ethaddr\"\n"+
+			"\n"+
+			"var (\n"+
+			"\t// Treasury is the DAO treasury.\n"+
+			"\tTreasury     = ethaddr.Something([20]byte{0x5a, 0xae, 0xb6, 0x05, 0x3f, 0x3e, 0x94, 0xc9, 0xb9, 0xa0, 0x9f, 0x33, 0x66, 0x94, 0x35, 0xe7, 0xef, 0x1b, 0xea, 0xed}) // 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n"+
+			"\tFeeRecipient = ethaddr.Something([20]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xde, 0xad}) // 0x000000000000000000000000000000000000dEaD\n"+
+			")\n"
+
+		actual, err := os.ReadFile(goPath)
+		if nil != err {
+			t.Fatalf("could not read generated Go source code: %s", err)
+		}
+
+		if expected != string(actual) {
+			t.Errorf("The actual generated Go source code is not what was expected.")
+			t.Logf("EXPECTED:\n%s", expected)
+			t.Logf("ACTUAL:\n%s", actual)
+		}
+	}
+
+	{
+		const expected =
+			"// SPDX-License-Identifier: UNLICENSED\n"+
+			"// Code generated by ethaddr-gen from mainnet.json. DO NOT EDIT.\n"+
+			"// Chain: mainnet\n"+
+			"pragma solidity ^0.8.0;\n"+
+			"\n"+
+			"library Deployments {\n"+
+			"    /// the DAO treasury\n"+
+			"    address internal constant TREASURY = 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed;\n"+
+			"    address internal constant FEE_RECIPIENT = 0x000000000000000000000000000000000000dEaD;\n"+
+			"}\n"
+
+		actual, err := os.ReadFile(solPath)
+		if nil != err {
+			t.Fatalf("could not read generated Solidity source code: %s", err)
+		}
+
+		if expected != string(actual) {
+			t.Errorf("The actual generated Solidity source code is not what was expected.")
+			t.Logf("EXPECTED:\n%s", expected)
+			t.Logf("ACTUAL:\n%s", actual)
+		}
+	}
+}
+
+func TestRun_fail(t *testing.T) {
+
+	tests := []struct{
+		Manifest string
+		ExpectedStderr string
+	}{
+		{
+			Manifest:
+				"addresses:\n"+
+				"  - name: Treasury\n"+
+				"    address: \"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\"\n"+
+				"  - name: treasury\n"+
+				"    address: \"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359\"\n",
+			ExpectedStderr: `manifest.yaml: entry #2 ("treasury"): duplicate name — the Go name Treasury is also used by entry #1`,
+		},
+		{
+			Manifest:
+				"addresses:\n"+
+				"  - name: Treasury\n"+
+				"    address: \"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\"\n"+
+				"  - name: Vault\n"+
+				"    address: \"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed\"\n",
+			ExpectedStderr: `manifest.yaml: entry #2 ("Vault"): duplicate address — 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed is also used by entry #1`,
+		},
+		{
+			Manifest:
+				"addresses:\n"+
+				"  - name: Treasury\n"+
+				"    address: \"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD\"\n",
+			ExpectedStderr: `manifest.yaml: entry #1 ("Treasury"): bad address "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD": ethaddr: bad EIP-55 / ERC-55 checksum — did you mean 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed?`,
+		},
+		{
+			Manifest:
+				"addresses:\n"+
+				"  - name: Treasury\n"+
+				"    address: \"5aaeb6053f3e94c9b9a09f33669435e7ef1beaed\"\n",
+			ExpectedStderr: `manifest.yaml: entry #1 ("Treasury"): bad address "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed": ethaddr: missing prefix for hexadecimal-literal (i.e., "0x")`,
+		},
+		{
+			Manifest:
+				"addresses:\n"+
+				"  - name: fee-recipient\n"+
+				"    address: \"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\"\n",
+			ExpectedStderr: `manifest.yaml: entry #1 ("fee-recipient"): bad name — it must start with a letter, and contain only letters, digits, and underscores`,
+		},
+		{
+			Manifest:
+				"adresses:\n"+
+				"  - name: Treasury\n"+
+				"    address: \"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\"\n",
+			ExpectedStderr: `field adresses not found`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var dir string = t.TempDir()
+
+		var manifestPath string = filepath.Join(dir, "manifest.yaml")
+		if err := os.WriteFile(manifestPath, []byte(test.Manifest), 0o600); nil != err {
+			t.Fatalf("could not write manifest: %s", err)
+		}
+
+		var goPath string = filepath.Join(dir, "addresses.go")
+
+		var stdout, stderr strings.Builder
+		exitCode := run([]string{"--go="+goPath, manifestPath}, &stdout, &stderr)
+		if expected, actual := 1, exitCode; expected != actual {
+			t.Errorf("For test #%d, the actual exit-code is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			t.Logf("STDERR:\n%s", stderr.String())
+			continue
+		}
+
+		if expected, actual := test.ExpectedStderr, stderr.String(); !strings.Contains(actual, expected) {
+			t.Errorf("For test #%d, the actual stderr is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+
+		if _, err := os.Stat(goPath); !os.IsNotExist(err) {
+			t.Errorf("For test #%d, expected no Go source code to be written.", testNumber)
+			continue
+		}
+	}
+}
+
+func TestRun_badFlags(t *testing.T) {
+
+	var dir string = t.TempDir()
+
+	var manifestPath string = filepath.Join(dir, "manifest.yaml")
+	if err := os.WriteFile(manifestPath, []byte("addresses:\n  - name: Treasury\n    address: \"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\"\n"), 0o600); nil != err {
+		t.Fatalf("could not write manifest: %s", err)
+	}
+
+	tests := []struct{
+		Args []string
+		ExpectedStderr string
+	}{
+		{
+			Args: []string{"--go=-", "--go-package=my-package"},
+			ExpectedStderr: `bad --go-package "my-package"`,
+		},
+		{
+			Args: []string{"--go=-", "--go-package=func"},
+			ExpectedStderr: `bad --go-package "func"`,
+		},
+		{
+			Args: []string{"--go=-", "--go-package=_"},
+			ExpectedStderr: `bad --go-package "_"`,
+		},
+		{
+			Args: []string{"--sol=-", "--sol-library=Addresses { } contract Evil"},
+			ExpectedStderr: `bad --sol-library "Addresses { } contract Evil"`,
+		},
+		{
+			Args: []string{"--sol=-", "--sol-pragma=^0.8.0;\ncontract Evil {}"},
+			ExpectedStderr: `bad --sol-pragma "^0.8.0;\ncontract Evil {}"`,
+		},
+		{
+			Args: []string{"--sol=-", "--sol-pragma="},
+			ExpectedStderr: `bad --sol-pragma ""`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var stdout, stderr strings.Builder
+		exitCode := run(append(test.Args, manifestPath), &stdout, &stderr)
+		if expected, actual := 2, exitCode; expected != actual {
+			t.Errorf("For test #%d, the actual exit-code is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			t.Logf("STDERR:\n%s", stderr.String())
+			continue
+		}
+
+		if expected, actual := test.ExpectedStderr, stderr.String(); !strings.Contains(actual, expected) {
+			t.Errorf("For test #%d, the actual stderr is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+
+		if expected, actual := "", stdout.String(); expected != actual {
+			t.Errorf("For test #%d, expected nothing to be written to stdout.", testNumber)
+			t.Logf("ACTUAL:\n%s", actual)
+			continue
+		}
+	}
+
+	for testNumber, pragma := range []string{"^0.8.0", "0.8.19", ">=0.8.0 <0.9.0", "0.8.0 - 0.8.20", "^0.7.0 || ^0.8.0", "~0.8.x"} {
+
+		var stdout, stderr strings.Builder
+		if expected, actual := 0, run([]string{"--sol=-", "--sol-pragma="+pragma, manifestPath}, &stdout, &stderr); expected != actual {
+			t.Errorf("For pragma test #%d, the actual exit-code is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			t.Logf("PRAGMA: %q", pragma)
+			t.Logf("STDERR:\n%s", stderr.String())
+			continue
+		}
+	}
+}
+
+func TestSolidityName(t *testing.T) {
+
+	tests := []struct{
+		Name string
+		Expected string
+	}{
+		{Name: "Treasury",      Expected: "TREASURY"},
+		{Name: "feeRecipient",  Expected: "FEE_RECIPIENT"},
+		{Name: "USDCToken",     Expected: "USDC_TOKEN"},
+		{Name: "Vault2Admin",   Expected: "VAULT2_ADMIN"},
+		{Name: "fee_recipient", Expected: "FEE_RECIPIENT"},
+		{Name: "WETH",          Expected: "WETH"},
+	}
+
+	for testNumber, test := range tests {
+		if expected, actual := test.Expected, solidityName(test.Name); expected != actual {
+			t.Errorf("For test #%d, the actual Solidity name is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			t.Logf("NAME: %q", test.Name)
+			continue
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+// manifest is what is in a manifest file.
+type manifest struct {
+	Chain     string          `json:"chain"     yaml:"chain"`
+	Addresses []manifestEntry `json:"addresses" yaml:"addresses"`
+}
+
+// manifestEntry is a single (unvalidated) entry in a manifest file.
+//
+// Address is a string (rather than an ethaddr.Address) so that the (textual) EIP-55 / ERC-55 checksum can be checked.
+type manifestEntry struct {
+	Name    string `json:"name"    yaml:"name"`
+	Address string `json:"address" yaml:"address"`
+	Comment string `json:"comment" yaml:"comment"`
+}
+
+// entry is a validated manifestEntry.
+type entry struct {
+	GoName       string
+	SolidityName string
+	Address      ethaddr.Address
+	Comment      string
+}
+
+var namePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+func loadManifest(path string) (manifest, error) {
+	var m manifest
+
+	data, err := os.ReadFile(path)
+	if nil != err {
+		return m, err
+	}
+
+	if strings.HasSuffix(strings.ToLower(path), ".json") {
+		var decoder *json.Decoder = json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&m)
+	} else {
+		var decoder *yaml.Decoder = yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&m)
+	}
+	if nil != err {
+		return m, err
+	}
+
+	return m, nil
+}
+
+// validate checks every entry in the manifest, and returns the validated entries, or every problem that was found.
+func (receiver manifest) validate() ([]entry, []string) {
+	var entries []entry
+	var problems []string
+
+	var goNames = map[string]int{}
+	var solidityNames = map[string]int{}
+	var addresses = map[ethaddr.Address]int{}
+
+	for index, me := range receiver.Addresses {
+		var number int = index + 1
+
+		problem := func(format string, a ...interface{}) {
+			problems = append(problems, fmt.Sprintf("entry #%d (%q): ", number, me.Name)+fmt.Sprintf(format, a...))
+		}
+
+		if !namePattern.MatchString(me.Name) {
+			problem("bad name — it must start with a letter, and contain only letters, digits, and underscores")
+			continue
+		}
+
+		address, err := ethaddr.ParseString(me.Address)
+		if nil != err {
+			problem("bad address %q: %s", me.Address, err)
+			continue
+		}
+		if suggestions, err := ethaddr.Diagnose(me.Address); nil != err {
+			var also string
+			for _, suggestion := range suggestions {
+				also += fmt.Sprintf(" — did you mean %s?", suggestion.Address.EIP55())
+			}
+			problem("bad address %q: %s%s", me.Address, err, also)
+			continue
+		}
+
+		var e = entry{
+			GoName:       goName(me.Name),
+			SolidityName: solidityName(me.Name),
+			Address:      address,
+			Comment:      me.Comment,
+		}
+
+		if other, found := goNames[e.GoName]; found {
+			problem("duplicate name — the Go name %s is also used by entry #%d", e.GoName, other)
+			continue
+		}
+		if other, found := solidityNames[e.SolidityName]; found {
+			problem("duplicate name — the Solidity name %s is also used by entry #%d", e.SolidityName, other)
+			continue
+		}
+		if other, found := addresses[address]; found {
+			problem("duplicate address — %s is also used by entry #%d", address.EIP55(), other)
+			continue
+		}
+		goNames[e.GoName] = number
+		solidityNames[e.SolidityName] = number
+		addresses[address] = number
+
+		entries = append(entries, e)
+	}
+
+	if 0 < len(problems) {
+		return nil, problems
+	}
+
+	return entries, nil
+}
+
+// goName returns the (exported) Go name for 'name'.
+//
+// For example, "feeRecipient" becomes "FeeRecipient".
+func goName(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// solidityName returns the (upper-case, underscore separated) Solidity constant name for 'name'.
+//
+// For example, "FeeRecipient" becomes "FEE_RECIPIENT", and "USDCToken" becomes "USDC_TOKEN".
+func solidityName(name string) string {
+	var runes []rune = []rune(name)
+
+	var builder strings.Builder
+	for index, r := range runes {
+		if 0 < index && unicode.IsUpper(r) && '_' != runes[index-1] {
+			var previousIsLower bool = unicode.IsLower(runes[index-1]) || unicode.IsDigit(runes[index-1])
+			var nextIsLower bool = index+1 < len(runes) && unicode.IsLower(runes[index+1])
+			if previousIsLower || nextIsLower {
+				builder.WriteRune('_')
+			}
+		}
+		builder.WriteRune(unicode.ToUpper(r))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+// These are the statuses that the check command reports.
+const (
+	statusChecksummed = "checksummed"  // mixed-case, with a valid EIP-55 / ERC-55 checksum
+	statusLowerCase   = "lowercase"    // no checksum
+	statusUpperCase   = "uppercase"    // no checksum
+	statusBadChecksum  = "bad-checksum"  // mixed-case, with an invalid EIP-55 / ERC-55 checksum
+	statusMissingDigit = "missing-digit" // 39 hexadecimal-symbols rather than 40 (a hexadecimal-symbol was probably dropped)
+	statusInvalid      = "invalid"       // not an eth-address at all
+)
+
+type checkResult struct {
+	Input       string   `json:"input"`
+	Status      string   `json:"status"`
+	Valid       bool     `json:"valid"`
+	EIP55       string   `json:"eip55,omitempty"`
+	Error       string   `json:"error,omitempty"`
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+func check(text string) checkResult {
+	var result = checkResult{Input: text}
+
+	suggestions, err := ethaddr.Diagnose(text)
+	if nil != err {
+		result.Error = err.Error()
+		switch {
+		case errors.Is(err, ethaddr.ErrBadChecksum):
+			result.Status = statusBadChecksum
+		case errors.Is(err, ethaddr.ErrDroppedHexadecimalSymbol):
+			result.Status = statusMissingDigit
+		default:
+			result.Status = statusInvalid
+		}
+		for _, suggestion := range suggestions {
+			result.Suggestions = append(result.Suggestions, suggestion.Address.EIP55())
+		}
+		return result
+	}
+
+	address, _ := ethaddr.ParseString(text)
+	result.Valid = true
+	result.EIP55 = address.EIP55()
+
+	var hex string = strings.TrimPrefix(text, "0x")
+	switch {
+	case strings.ToLower(hex) == hex:
+		result.Status = statusLowerCase
+	case strings.ToUpper(hex) == hex:
+		result.Status = statusUpperCase
+	default:
+		result.Status = statusChecksummed
+	}
+
+	return result
+}
+
+func runCheck(name string, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	jsonOutput, rest, err := parseFlags(name, args, stderr)
+	if nil != err {
+		return exitUsage
+	}
+
+	var encoder *json.Encoder = json.NewEncoder(stdout)
+	var exitCode int = exitOK
+
+	err = forEachInput(rest, stdin, func(in input) {
+		var result checkResult = check(in.Text)
+		if !result.Valid {
+			exitCode = exitInvalid
+		}
+
+		if jsonOutput {
+			encoder.Encode(result)
+			return
+		}
+
+		fmt.Fprintf(stdout, "%s\t%s", in.Text, result.Status)
+		if 0 < len(result.Suggestions) {
+			fmt.Fprintf(stdout, "\tdid you mean: %s", strings.Join(result.Suggestions, " or "))
+		}
+		fmt.Fprintln(stdout)
+
+		if !result.Valid {
+			fmt.Fprintf(stderr, "ethaddr %s: %s: %q: %s\n", name, in.Location, in.Text, result.Error)
+		}
+	})
+	if nil != err {
+		fmt.Fprintf(stderr, "ethaddr %s: problem reading stdin: %s\n", name, err)
+		return exitInvalid
+	}
+
+	return exitCode
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/reiver/go-erorr"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+const errNotDecimalInteger = erorr.Error("not a decimal integer")
+
+// converter returns the Run func for a command that converts each input into a single output.
+func converter(convert func(text string) (interface{}, string, error)) func(string, []string, io.Reader, io.Writer, io.Writer) int {
+	return func(name string, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+		jsonOutput, rest, err := parseFlags(name, args, stderr)
+		if nil != err {
+			return exitUsage
+		}
+
+		var encoder *json.Encoder = json.NewEncoder(stdout)
+		var exitCode int = exitOK
+
+		err = forEachInput(rest, stdin, func(in input) {
+			value, text, err := convert(in.Text)
+			if nil != err {
+				exitCode = exitInvalid
+				fmt.Fprintf(stderr, "ethaddr %s: %s: %q: %s\n", name, in.Location, in.Text, err)
+			}
+
+			switch {
+			case jsonOutput && nil != err:
+				encoder.Encode(struct {
+					Input string `json:"input"`
+					Error string `json:"error"`
+				}{in.Text, err.Error()})
+			case jsonOutput:
+				encoder.Encode(struct {
+					Input  string      `json:"input"`
+					Output interface{} `json:"output"`
+				}{in.Text, value})
+			case nil == err:
+				fmt.Fprintln(stdout, text)
+			}
+		})
+		if nil != err {
+			fmt.Fprintf(stderr, "ethaddr %s: problem reading stdin: %s\n", name, err)
+			return exitInvalid
+		}
+
+		return exitCode
+	}
+}
+
+func convertChecksum(text string) (interface{}, string, error) {
+	address, err := ethaddr.ParseString(text)
+	if nil != err {
+		return nil, "", err
+	}
+
+	var eip55 string = address.EIP55()
+	return eip55, eip55, nil
+}
+
+func convertLower(text string) (interface{}, string, error) {
+	address, err := ethaddr.ParseString(text)
+	if nil != err {
+		return nil, "", err
+	}
+
+	var lower string = strings.ToLower(address.EIP55())
+	return lower, lower, nil
+}
+
+func convertBytes(text string) (interface{}, string, error) {
+	address, err := ethaddr.ParseString(text)
+	if nil != err {
+		return nil, "", err
+	}
+
+	var bytes []byte = address.Bytes()
+
+	var ints []int = make([]int, len(bytes))
+	var hexes []string = make([]string, len(bytes))
+	for index, b := range bytes {
+		ints[index] = int(b)
+		hexes[index] = fmt.Sprintf("%02x", b)
+	}
+
+	return ints, strings.Join(hexes, " "), nil
+}
+
+func convertInt(text string) (interface{}, string, error) {
+	address, err := ethaddr.ParseString(text)
+	if nil != err {
+		return nil, "", err
+	}
+
+	var decimal string = address.BigInt().String()
+	// The integer is written as a JSON string, since most JSON decoders cannot hold a 160-bit number.
+	return decimal, decimal, nil
+}
+
+func convertFromInt(text string) (interface{}, string, error) {
+	bigint, ok := new(big.Int).SetString(text, 10)
+	if !ok {
+		return nil, "", errNotDecimalInteger
+	}
+
+	address, err := ethaddr.BigInt(bigint)
+	if nil != err {
+		return nil, "", err
+	}
+
+	var eip55 string = address.EIP55()
+	return eip55, eip55, nil
+}
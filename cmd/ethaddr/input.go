@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// input is a single (non-blank) piece of input, along with where it came from (for error messages).
+type input struct {
+	Location string
+	Text     string
+}
+
+// parseFlags parses the flags that are common to the commands that take eth-addresses as input.
+func parseFlags(name string, args []string, stderr io.Writer) (jsonOutput bool, rest []string, err error) {
+	var fs *flag.FlagSet = flag.NewFlagSet("ethaddr "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.BoolVar(&jsonOutput, "json", false, "write each result as a JSON object on its own line")
+
+	err = fs.Parse(args)
+	if nil != err {
+		return false, nil, err
+	}
+
+	return jsonOutput, fs.Args(), nil
+}
+
+// forEachInput calls 'fn' for each of the arguments, or (if there are no arguments) for each non-blank line of 'stdin'.
+func forEachInput(args []string, stdin io.Reader, fn func(input)) error {
+	if 0 < len(args) {
+		for index, arg := range args {
+			fn(input{
+				Location: fmt.Sprintf("argument %d", index+1),
+				Text:     strings.TrimSpace(arg),
+			})
+		}
+		return nil
+	}
+
+	var scanner *bufio.Scanner = bufio.NewScanner(stdin)
+	var lineNumber int
+	for scanner.Scan() {
+		lineNumber++
+
+		var text string = strings.TrimSpace(scanner.Text())
+		if "" == text {
+			continue
+		}
+
+		fn(input{
+			Location: fmt.Sprintf("line %d", lineNumber),
+			Text:     text,
+		})
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,101 @@
+// Command ethaddr validates and converts eth-addresses.
+//
+// Usage:
+//
+//	ethaddr <command> [--json] [eth-address ...]
+//
+// The commands are:
+//
+//	check      validate, and report the EIP-55 / ERC-55 status
+//	checksum   normalize to the EIP-55 / ERC-55 encoding
+//	lower      normalize to lower-case
+//	bytes      show the 20 bytes
+//	int        convert to a (decimal) integer
+//	from-int   convert from a (decimal) integer
+//	set        set operations (union, intersect, diff, dedupe, sort) on files of eth-addresses
+//
+// If no eth-addresses are given as arguments, then they are read from stdin, one per line.
+//
+// With --json, each result is written as a JSON object on its own line.
+//
+// The set command is different. It is used like:
+//
+//	ethaddr set <operation> [file ...]
+//
+// The exit-code is 1 if any of the input is invalid, and 2 if the command-line is invalid.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+const (
+	exitOK      = 0
+	exitInvalid = 1
+	exitUsage   = 2
+)
+
+// command is a sub-command of the ethaddr program.
+type command struct {
+	Summary string
+	Run     func(name string, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int
+}
+
+var commands = map[string]command{
+	"check":    {Summary: "validate, and report the EIP-55 / ERC-55 status", Run: runCheck},
+	"checksum": {Summary: "normalize to the EIP-55 / ERC-55 encoding",       Run: converter(convertChecksum)},
+	"lower":    {Summary: "normalize to lower-case",                         Run: converter(convertLower)},
+	"bytes":    {Summary: "show the 20 bytes",                               Run: converter(convertBytes)},
+	"int":      {Summary: "convert to a (decimal) integer",                  Run: converter(convertInt)},
+	"from-int": {Summary: "convert from a (decimal) integer",                Run: converter(convertFromInt)},
+	"set":      {Summary: "set operations on files of eth-addresses",        Run: runSet},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) < 1 {
+		usage(stderr)
+		return exitUsage
+	}
+
+	var name string = args[0]
+	switch name {
+	case "help", "-h", "-help", "--help":
+		usage(stdout)
+		return exitOK
+	}
+
+	cmd, found := commands[name]
+	if !found {
+		fmt.Fprintf(stderr, "ethaddr: unknown command %q\n\n", name)
+		usage(stderr)
+		return exitUsage
+	}
+
+	return cmd.Run(name, args[1:], stdin, stdout, stderr)
+}
+
+func usage(writer io.Writer) {
+	fmt.Fprintln(writer, "usage: ethaddr <command> [--json] [eth-address ...]")
+	fmt.Fprintln(writer)
+	fmt.Fprintln(writer, "commands:")
+
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(writer, "  %-10s %s\n", name, commands[name].Summary)
+	}
+
+	fmt.Fprintln(writer)
+	fmt.Fprintln(writer, "If no eth-addresses are given as arguments, then they are read from stdin, one per line.")
+}
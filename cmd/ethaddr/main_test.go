@@ -0,0 +1,119 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+
+	tests := []struct{
+		Args []string
+		Stdin string
+		ExpectedStdout string
+		ExpectedExitCode int
+	}{
+		{
+			Args: []string{"checksum", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"},
+			ExpectedStdout: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n",
+			ExpectedExitCode: exitOK,
+		},
+		{
+			Args: []string{"checksum"},
+			Stdin: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed\n\n  0XNOPE  \n0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359\n",
+			ExpectedStdout: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359\n",
+			ExpectedExitCode: exitInvalid,
+		},
+		{
+			Args: []string{"lower", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+			ExpectedStdout: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed\n",
+			ExpectedExitCode: exitOK,
+		},
+		{
+			Args: []string{"bytes", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+			ExpectedStdout: "5a ae b6 05 3f 3e 94 c9 b9 a0 9f 33 66 94 35 e7 ef 1b ea ed\n",
+			ExpectedExitCode: exitOK,
+		},
+		{
+			Args: []string{"int", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0x000000000000000000000000000000000000000f"},
+			ExpectedStdout: "517705355260207604495801938720638392742277016301\n15\n",
+			ExpectedExitCode: exitOK,
+		},
+		{
+			Args: []string{"from-int", "517705355260207604495801938720638392742277016301"},
+			ExpectedStdout: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n",
+			ExpectedExitCode: exitOK,
+		},
+		{
+			Args: []string{"from-int", "1461501637330902918203684832716283019655932542976"},
+			ExpectedStdout: "",
+			ExpectedExitCode: exitInvalid,
+		},
+		{
+			Args: []string{"int", "--json", "0x000000000000000000000000000000000000000f", "0x0f"},
+			ExpectedStdout:
+				`{"input":"0x000000000000000000000000000000000000000f","output":"15"}`+"\n"+
+				`{"input":"0x0f","error":"ethaddr: the eth-address is expected to be 42 or 41 bytes long, but was actually 4 bytes long"}`+"\n",
+			ExpectedExitCode: exitInvalid,
+		},
+		{
+			Args: []string{"check", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD"},
+			ExpectedStdout:
+				"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\tchecksummed\n"+
+				"0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED\tuppercase\n"+
+				"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD\tbad-checksum\tdid you mean: 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n",
+			ExpectedExitCode: exitInvalid,
+		},
+		{
+			Args: []string{"check", "0x5aAeb6053F3E94C9b9A09f3669435E7Ef1BeAed"},
+			ExpectedStdout:
+				"0x5aAeb6053F3E94C9b9A09f3669435E7Ef1BeAed\tmissing-digit\tdid you mean: 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n",
+			ExpectedExitCode: exitInvalid,
+		},
+		{
+			Args: []string{"check", "--json", "0x5aAeb6053F3E94C9b9A09f3669435E7Ef1BeAed"},
+			ExpectedStdout: `{"input":"0x5aAeb6053F3E94C9b9A09f3669435E7Ef1BeAed","status":"missing-digit","valid":false,"error":"ethaddr: eth-address has 39 hexadecimal-symbols rather than 40 (a hexadecimal-symbol was probably dropped)","suggestions":["0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"]}`+"\n",
+			ExpectedExitCode: exitInvalid,
+		},
+		{
+			Args: []string{"check", "--json", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"},
+			ExpectedStdout: `{"input":"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed","status":"lowercase","valid":true,"eip55":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}`+"\n",
+			ExpectedExitCode: exitOK,
+		},
+		{
+			Args: []string{},
+			ExpectedStdout: "",
+			ExpectedExitCode: exitUsage,
+		},
+		{
+			Args: []string{"nope"},
+			ExpectedStdout: "",
+			ExpectedExitCode: exitUsage,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var stdout strings.Builder
+		var stderr strings.Builder
+
+		actualExitCode := run(test.Args, strings.NewReader(test.Stdin), &stdout, &stderr)
+
+		if expected, actual := test.ExpectedExitCode, actualExitCode; expected != actual {
+			t.Errorf("For test #%d, the actual exit-code is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			t.Logf("ARGS: %#v", test.Args)
+			t.Logf("STDERR:\n%s", stderr.String())
+			continue
+		}
+
+		if expected, actual := test.ExpectedStdout, stdout.String(); expected != actual {
+			t.Errorf("For test #%d, the actual stdout is not what was expected.", testNumber)
+			t.Logf("EXPECTED:\n%s", expected)
+			t.Logf("ACTUAL:\n%s", actual)
+			t.Logf("ARGS: %#v", test.Args)
+			continue
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/reiver/go-erorr"
+	"github.com/reiver/go-ethaddr"
+)
+
+// setOperations are the sub-commands of the set command.
+//
+// Each one is given the (parsed) eth-addresses of each file, in the order the files were given.
+var setOperations = map[string]struct {
+	Summary  string
+	MinFiles int
+	Operate  func(lists [][]ethaddr.Address) []ethaddr.Address
+}{
+	"union":     {Summary: "eth-addresses in any of the files",                           MinFiles: 1, Operate: setUnion},
+	"intersect": {Summary: "eth-addresses in all of the files",                           MinFiles: 1, Operate: setIntersect},
+	"diff":      {Summary: "eth-addresses in the first file but not in any of the others", MinFiles: 1, Operate: setDiff},
+	"dedupe":    {Summary: "eth-addresses with duplicates removed",                       MinFiles: 0, Operate: setUnion},
+	"sort":      {Summary: "eth-addresses with duplicates kept",                          MinFiles: 0, Operate: setSort},
+}
+
+func setUsage(writer io.Writer) {
+	fmt.Fprintln(writer, "usage: ethaddr set <operation> [file ...]")
+	fmt.Fprintln(writer)
+	fmt.Fprintln(writer, "operations:")
+
+	var names []string
+	for name := range setOperations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(writer, "  %-10s %s\n", name, setOperations[name].Summary)
+	}
+
+	fmt.Fprintln(writer)
+	fmt.Fprintln(writer, "Each file contains one eth-address per line (in any letter-case). Blank lines, and lines starting with \"#\", are ignored.")
+	fmt.Fprintln(writer, "A file named \"-\" is stdin (and may only be given once). If no files are given to dedupe or sort, then stdin is read.")
+	fmt.Fprintln(writer, "The output is sorted, and EIP-55 / ERC-55 encoded.")
+}
+
+func runSet(name string, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) < 1 {
+		setUsage(stderr)
+		return exitUsage
+	}
+
+	var operationName string = args[0]
+	var files []string = args[1:]
+
+	operation, found := setOperations[operationName]
+	if !found {
+		fmt.Fprintf(stderr, "ethaddr %s: unknown operation %q\n\n", name, operationName)
+		setUsage(stderr)
+		return exitUsage
+	}
+
+	if len(files) < operation.MinFiles {
+		fmt.Fprintf(stderr, "ethaddr %s %s: expected at least %d file(s)\n\n", name, operationName, operation.MinFiles)
+		setUsage(stderr)
+		return exitUsage
+	}
+	if len(files) < 1 {
+		files = []string{"-"}
+	}
+
+	// stdin can only be read once, so a second "-" would silently be an empty set.
+	{
+		var numStdin int
+		for _, file := range files {
+			if "-" == file {
+				numStdin++
+			}
+		}
+		if 1 < numStdin {
+			fmt.Fprintf(stderr, "ethaddr %s %s: \"-\" (stdin) was given %d times, but may only be given once\n\n", name, operationName, numStdin)
+			setUsage(stderr)
+			return exitUsage
+		}
+	}
+
+	var lists [][]ethaddr.Address
+	var invalid bool
+	for _, file := range files {
+		list, problems, err := readAddressFile(file, stdin)
+		if nil != err {
+			fmt.Fprintf(stderr, "ethaddr %s %s: %s\n", name, operationName, err)
+			return exitInvalid
+		}
+		for _, problem := range problems {
+			invalid = true
+			fmt.Fprintf(stderr, "ethaddr %s %s: %s\n", name, operationName, problem)
+		}
+
+		lists = append(lists, list)
+	}
+
+	// Output is not written if there were any unparseable lines, since the result would be silently wrong.
+	if invalid {
+		return exitInvalid
+	}
+
+	var writer *bufio.Writer = bufio.NewWriter(stdout)
+	for _, address := range operation.Operate(lists) {
+		fmt.Fprintln(writer, address.EIP55())
+	}
+	if err := writer.Flush(); nil != err {
+		fmt.Fprintf(stderr, "ethaddr %s %s: problem writing output: %s\n", name, operationName, err)
+		return exitInvalid
+	}
+
+	return exitOK
+}
+
+// readAddressFile reads the eth-addresses in the file named 'path' (or 'stdin' if 'path' is "-").
+//
+// Any unparseable lines are returned as problems, which include the file name and line number.
+func readAddressFile(path string, stdin io.Reader) (addresses []ethaddr.Address, problems []string, err error) {
+	var reader io.Reader = stdin
+	if "-" != path {
+		file, err := os.Open(path)
+		if nil != err {
+			return nil, nil, err
+		}
+		defer file.Close()
+
+		reader = file
+	}
+
+	var scanner *bufio.Scanner = bufio.NewScanner(reader)
+	var lineNumber int
+	for scanner.Scan() {
+		lineNumber++
+
+		var text string = strings.TrimSpace(scanner.Text())
+		if "" == text || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		address, err := ethaddr.ParseString(text)
+		if nil != err {
+			problems = append(problems, fmt.Sprintf("%s:%d: %q: %s", path, lineNumber, text, err))
+			continue
+		}
+
+		addresses = append(addresses, address)
+	}
+	if err := scanner.Err(); nil != err {
+		return nil, nil, erorr.Errorf("%s: %w", path, err)
+	}
+
+	return addresses, problems, nil
+}
+
+func sortAddresses(addresses []ethaddr.Address) []ethaddr.Address {
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+	return addresses
+}
+
+func setSort(lists [][]ethaddr.Address) []ethaddr.Address {
+	var result []ethaddr.Address
+	for _, list := range lists {
+		result = append(result, list...)
+	}
+
+	return sortAddresses(result)
+}
+
+func setUnion(lists [][]ethaddr.Address) []ethaddr.Address {
+	var seen = map[ethaddr.Address]struct{}{}
+	var result []ethaddr.Address
+
+	for _, list := range lists {
+		for _, address := range list {
+			if _, found := seen[address]; found {
+				continue
+			}
+			seen[address] = struct{}{}
+			result = append(result, address)
+		}
+	}
+
+	return sortAddresses(result)
+}
+
+func setIntersect(lists [][]ethaddr.Address) []ethaddr.Address {
+	var result []ethaddr.Address = setUnion(lists[:1])
+
+	for _, list := range lists[1:] {
+		var in = map[ethaddr.Address]struct{}{}
+		for _, address := range list {
+			in[address] = struct{}{}
+		}
+
+		var kept []ethaddr.Address
+		for _, address := range result {
+			if _, found := in[address]; found {
+				kept = append(kept, address)
+			}
+		}
+		result = kept
+	}
+
+	return result
+}
+
+func setDiff(lists [][]ethaddr.Address) []ethaddr.Address {
+	var out = map[ethaddr.Address]struct{}{}
+	for _, list := range lists[1:] {
+		for _, address := range list {
+			out[address] = struct{}{}
+		}
+	}
+
+	var result []ethaddr.Address
+	for _, address := range setUnion(lists[:1]) {
+		if _, found := out[address]; !found {
+			result = append(result, address)
+		}
+	}
+
+	return result
+}
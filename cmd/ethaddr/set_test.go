@@ -0,0 +1,146 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun_set(t *testing.T) {
+
+	var dir string = t.TempDir()
+
+	writeFile := func(name string, content string) string {
+		var path string = filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); nil != err {
+			t.Fatalf("could not write file %q: %s", path, err)
+		}
+		return path
+	}
+
+	var a string = writeFile("a.txt",
+		"# list A\n"+
+		"0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359\n"+
+		"0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED\n"+
+		"\n"+
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB\n"+
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed\n",
+	)
+	var b string = writeFile("b.txt",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb\n"+
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n",
+	)
+	var bad string = writeFile("bad.txt",
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n"+
+		"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n"+
+		"0x1234\n",
+	)
+
+	tests := []struct{
+		Args []string
+		Stdin string
+		ExpectedStdout string
+		ExpectedStderr string
+		ExpectedExitCode int
+	}{
+		{
+			Args: []string{"set", "union", a, b},
+			ExpectedStdout:
+				"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n"+
+				"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb\n"+
+				"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB\n"+
+				"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359\n",
+			ExpectedExitCode: exitOK,
+		},
+		{
+			Args: []string{"set", "intersect", a, b},
+			ExpectedStdout:
+				"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n",
+			ExpectedExitCode: exitOK,
+		},
+		{
+			Args: []string{"set", "diff", a, b},
+			ExpectedStdout:
+				"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB\n"+
+				"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359\n",
+			ExpectedExitCode: exitOK,
+		},
+		{
+			Args: []string{"set", "dedupe", a},
+			ExpectedStdout:
+				"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n"+
+				"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB\n"+
+				"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359\n",
+			ExpectedExitCode: exitOK,
+		},
+		{
+			Args: []string{"set", "sort"},
+			Stdin: "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359\n0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed\n0xFB6916095CA1DF60BB79CE92CE3EA74C37C5D359\n",
+			ExpectedStdout:
+				"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n"+
+				"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359\n"+
+				"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359\n",
+			ExpectedExitCode: exitOK,
+		},
+		{
+			Args: []string{"set", "union", a, bad},
+			ExpectedStdout: "",
+			ExpectedStderr:
+				"ethaddr set union: "+bad+`:2: "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed": ethaddr: missing prefix for hexadecimal-literal (i.e., "0x")`+"\n"+
+				"ethaddr set union: "+bad+`:3: "0x1234": ethaddr: the eth-address is expected to be 42 or 41 bytes long, but was actually 6 bytes long`+"\n",
+			ExpectedExitCode: exitInvalid,
+		},
+		{
+			Args: []string{"set", "diff"},
+			ExpectedExitCode: exitUsage,
+		},
+		{
+			Args: []string{"set", "xor", a, b},
+			ExpectedExitCode: exitUsage,
+		},
+		{
+			Args: []string{"set", "union", filepath.Join(dir, "does-not-exist.txt")},
+			ExpectedExitCode: exitInvalid,
+		},
+		{
+			Args: []string{"set", "intersect", "-", a, "-"},
+			Stdin: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n",
+			ExpectedStdout: "",
+			ExpectedExitCode: exitUsage,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var stdout strings.Builder
+		var stderr strings.Builder
+
+		actualExitCode := run(test.Args, strings.NewReader(test.Stdin), &stdout, &stderr)
+
+		if expected, actual := test.ExpectedExitCode, actualExitCode; expected != actual {
+			t.Errorf("For test #%d, the actual exit-code is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			t.Logf("ARGS: %#v", test.Args)
+			t.Logf("STDERR:\n%s", stderr.String())
+			continue
+		}
+
+		if expected, actual := test.ExpectedStdout, stdout.String(); expected != actual {
+			t.Errorf("For test #%d, the actual stdout is not what was expected.", testNumber)
+			t.Logf("EXPECTED:\n%s", expected)
+			t.Logf("ACTUAL:\n%s", actual)
+			t.Logf("ARGS: %#v", test.Args)
+			continue
+		}
+
+		if expected, actual := test.ExpectedStderr, stderr.String(); "" != expected && expected != actual {
+			t.Errorf("For test #%d, the actual stderr is not what was expected.", testNumber)
+			t.Logf("EXPECTED:\n%s", expected)
+			t.Logf("ACTUAL:\n%s", actual)
+			t.Logf("ARGS: %#v", test.Args)
+			continue
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	DefaultMaxBodyBytes int64 = 1024 * 1024
+	DefaultMaxBatch     int   = 1000
+)
+
+// Config configures the handler returned by NewHandler.
+//
+// The zero value is usable — any zero (or negative) field gets its default.
+type Config struct {
+	MaxBodyBytes int64
+	MaxBatch     int
+}
+
+func (receiver Config) maxBodyBytes() int64 {
+	if receiver.MaxBodyBytes <= 0 {
+		return DefaultMaxBodyBytes
+	}
+	return receiver.MaxBodyBytes
+}
+
+func (receiver Config) maxBatch() int {
+	if receiver.MaxBatch <= 0 {
+		return DefaultMaxBatch
+	}
+	return receiver.MaxBatch
+}
+
+// NewHandler returns the http.Handler of the ethaddrd server.
+func NewHandler(config Config) http.Handler {
+	var mux *http.ServeMux = http.NewServeMux()
+
+	for name, op := range operations {
+		mux.Handle("/v1/"+name, singleHandler{config: config, op: op})
+		mux.Handle("/v1/batch/"+name, batchHandler{config: config, op: op})
+	}
+
+	return mux
+}
+
+type singleRequest struct {
+	Input *string `json:"input"`
+}
+
+type batchRequest struct {
+	Inputs []string `json:"inputs"`
+}
+
+type batchResponse struct {
+	Results []Result `json:"results"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// singleHandler serves an operation on a single input.
+//
+// The response status is 200 if the input is valid, and 422 if it is not.
+type singleHandler struct {
+	config Config
+	op     operation
+}
+
+func (receiver singleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var input string
+
+	switch r.Method {
+	case http.MethodGet:
+		values, found := r.URL.Query()["input"]
+		if !found || 1 != len(values) {
+			writeError(w, http.StatusBadRequest, "expected exactly one \"input\" query-parameter")
+			return
+		}
+		input = values[0]
+	case http.MethodPost:
+		var request singleRequest
+		if !decodeBody(w, r, receiver.config, &request) {
+			return
+		}
+		if nil == request.Input {
+			writeError(w, http.StatusBadRequest, "missing \"input\" field")
+			return
+		}
+		input = *request.Input
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var result Result = receiver.op(input)
+
+	var status int = http.StatusOK
+	if !result.Valid {
+		status = http.StatusUnprocessableEntity
+	}
+
+	writeJSON(w, status, result)
+}
+
+// batchHandler serves an operation on many inputs.
+//
+// The response status is 200 even if some (or all) of the inputs are invalid — each result says whether it is valid.
+type batchHandler struct {
+	config Config
+	op     operation
+}
+
+func (receiver batchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if http.MethodPost != r.Method {
+		w.Header().Set("Allow", "POST")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var request batchRequest
+	if !decodeBody(w, r, receiver.config, &request) {
+		return
+	}
+
+	if max := receiver.config.maxBatch(); max < len(request.Inputs) {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("too many inputs (%d) — the maximum is %d", len(request.Inputs), max))
+		return
+	}
+
+	var response = batchResponse{
+		Results: make([]Result, 0, len(request.Inputs)),
+	}
+	for _, input := range request.Inputs {
+		response.Results = append(response.Results, receiver.op(input))
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// decodeBody decodes the (JSON) request body into 'dst'.
+//
+// If it cannot, then it writes an error response and returns false.
+func decodeBody(w http.ResponseWriter, r *http.Request, config Config, dst interface{}) bool {
+	var decoder *json.Decoder = json.NewDecoder(http.MaxBytesReader(w, r.Body, config.maxBodyBytes()))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(dst)
+	if nil != err {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body too large — the maximum is %d bytes", maxBytesError.Limit))
+			return false
+		}
+
+		writeError(w, http.StatusBadRequest, "bad JSON request body: "+err.Error())
+		return false
+	}
+
+	if decoder.More() {
+		writeError(w, http.StatusBadRequest, "bad JSON request body: unexpected data after JSON value")
+		return false
+	}
+
+	return true
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Error: message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
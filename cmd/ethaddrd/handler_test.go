@@ -0,0 +1,152 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+
+	tests := []struct{
+		Method string
+		Target string
+		Body string
+		ExpectedStatus int
+		ExpectedBody string
+	}{
+		{
+			Method: http.MethodPost,
+			Target: "/v1/parse",
+			Body: `{"input":"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"}`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody: `{"input":"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed","valid":true,"eip55":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed","checksum":"lowercase","bigint":"517705355260207604495801938720638392742277016301","kind":"regular"}`+"\n",
+		},
+		{
+			Method: http.MethodGet,
+			Target: "/v1/validate?input=0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD",
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody: `{"input":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD","valid":true,"checksum":"invalid"}`+"\n",
+		},
+		{
+			Method: http.MethodGet,
+			Target: "/v1/checksum?input=0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody: `{"input":"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed","valid":true,"eip55":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}`+"\n",
+		},
+		{
+			Method: http.MethodPost,
+			Target: "/v1/checksum",
+			Body: `{"input":"0x5aae"}`,
+			ExpectedStatus: http.StatusUnprocessableEntity,
+			ExpectedBody: `{"input":"0x5aae","valid":false,"error":"ethaddr: the eth-address is expected to be 42 or 41 bytes long, but was actually 6 bytes long"}`+"\n",
+		},
+		{
+			Method: http.MethodPost,
+			Target: "/v1/bigint",
+			Body: `{"input":"0x000000000000000000000000000000000000000F"}`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody: `{"input":"0x000000000000000000000000000000000000000F","valid":true,"bigint":"15"}`+"\n",
+		},
+		{
+			Method: http.MethodPost,
+			Target: "/v1/from-bigint",
+			Body: `{"input":"57005"}`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody: `{"input":"57005","valid":true,"eip55":"0x000000000000000000000000000000000000dEaD","bigint":"57005"}`+"\n",
+		},
+		{
+			Method: http.MethodPost,
+			Target: "/v1/from-bigint",
+			Body: `{"input":"1461501637330902918203684832716283019655932542975"}`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody: `{"input":"1461501637330902918203684832716283019655932542975","valid":true,"eip55":"0xFFfFfFffFFfffFFfFFfFFFFFffFFFffffFfFFFfF","bigint":"1461501637330902918203684832716283019655932542975"}`+"\n",
+		},
+		{
+			Method: http.MethodPost,
+			Target: "/v1/from-bigint",
+			Body: `{"input":"14615016373309029182036848327162830196559325429750"}`,
+			ExpectedStatus: http.StatusUnprocessableEntity,
+			ExpectedBody: `{"input":"","valid":false,"error":"too many digits"}`+"\n",
+		},
+		{
+			Method: http.MethodPost,
+			Target: "/v1/kind",
+			Body: `{"input":"0x0000000000000000000000000000000000000001"}`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody: `{"input":"0x0000000000000000000000000000000000000001","valid":true,"kind":"precompile"}`+"\n",
+		},
+		{
+			Method: http.MethodPost,
+			Target: "/v1/batch/kind",
+			Body: `{"inputs":["0x000000000000000000000000000000000000dEaD","nope"]}`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody: `{"results":[{"input":"0x000000000000000000000000000000000000dEaD","valid":true,"kind":"burn"},{"input":"nope","valid":false,"error":"ethaddr: missing prefix for hexadecimal-literal (i.e., \"0x\")"}]}`+"\n",
+		},
+		{
+			Method: http.MethodPost,
+			Target: "/v1/batch/checksum",
+			Body: `{"inputs":["0x1","0x2","0x3"]}`,
+			ExpectedStatus: http.StatusRequestEntityTooLarge,
+			ExpectedBody: `{"error":"too many inputs (3) — the maximum is 2"}`+"\n",
+		},
+		{
+			Method: http.MethodPost,
+			Target: "/v1/checksum",
+			Body: `{"input":"`+strings.Repeat("0", 2048)+`"}`,
+			ExpectedStatus: http.StatusRequestEntityTooLarge,
+			ExpectedBody: `{"error":"request body too large — the maximum is 1024 bytes"}`+"\n",
+		},
+		{
+			Method: http.MethodPost,
+			Target: "/v1/checksum",
+			Body: `{"address":"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"}`,
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Method: http.MethodPost,
+			Target: "/v1/checksum",
+			Body: `{}`,
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody: `{"error":"missing \"input\" field"}`+"\n",
+		},
+		{
+			Method: http.MethodGet,
+			Target: "/v1/batch/checksum",
+			ExpectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			Method: http.MethodGet,
+			Target: "/v1/nope",
+			ExpectedStatus: http.StatusNotFound,
+		},
+	}
+
+	var handler http.Handler = NewHandler(Config{MaxBodyBytes: 1024, MaxBatch: 2})
+
+	for testNumber, test := range tests {
+
+		var request *http.Request = httptest.NewRequest(test.Method, test.Target, strings.NewReader(test.Body))
+		var recorder *httptest.ResponseRecorder = httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, request)
+
+		if expected, actual := test.ExpectedStatus, recorder.Code; expected != actual {
+			t.Errorf("For test #%d, the actual HTTP status is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			t.Logf("TARGET: %s %s", test.Method, test.Target)
+			t.Logf("BODY: %s", recorder.Body.String())
+			continue
+		}
+
+		if expected, actual := test.ExpectedBody, recorder.Body.String(); "" != expected && expected != actual {
+			t.Errorf("For test #%d, the actual HTTP response body is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			t.Logf("TARGET: %s %s", test.Method, test.Target)
+			continue
+		}
+	}
+}
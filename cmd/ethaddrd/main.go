@@ -0,0 +1,60 @@
+// Command ethaddrd is an HTTP server that validates and converts eth-addresses,
+// so that programs not written in Go can use exactly the same rules as package ethaddr.
+//
+// Usage:
+//
+//	ethaddrd [--addr=:8080] [--max-body-bytes=1048576] [--max-batch=1000]
+//
+// Each endpoint accepts a POST with a JSON body like:
+//
+//	{"input":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}
+//
+// (or a GET with an "input" query-parameter), and returns a JSON result.
+//
+// The endpoints are:
+//
+//	/v1/parse        everything below, at once
+//	/v1/validate     whether the input is valid, and its EIP-55 / ERC-55 checksum status
+//	/v1/checksum     the EIP-55 / ERC-55 encoding
+//	/v1/bigint       the (decimal) integer value — written as a JSON string
+//	/v1/from-bigint  the eth-address for a (decimal) integer
+//	/v1/kind         the classification (zero, precompile, burn, system, ...)
+//
+// Each endpoint also has a batch version under /v1/batch/ (for example, /v1/batch/checksum) that accepts a POST with a JSON body like:
+//
+//	{"inputs":["0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed","0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"]}
+//
+// and returns:
+//
+//	{"results":[ ... ]}
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+)
+
+func main() {
+	var addr string
+	var config Config
+
+	flag.StringVar(&addr, "addr", ":8080", "the TCP address to listen on")
+	flag.Int64Var(&config.MaxBodyBytes, "max-body-bytes", DefaultMaxBodyBytes, "the maximum size (in bytes) of a request body")
+	flag.IntVar(&config.MaxBatch, "max-batch", DefaultMaxBatch, "the maximum number of inputs in a batch request")
+	flag.Parse()
+
+	var server = http.Server{
+		Addr:              addr,
+		Handler:           NewHandler(config),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+		IdleTimeout:       60 * time.Second,
+		MaxHeaderBytes:    16 * 1024,
+	}
+
+	log.Printf("ethaddrd: listening on %s", addr)
+	log.Fatal(server.ListenAndServe())
+}
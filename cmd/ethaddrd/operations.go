@@ -0,0 +1,159 @@
+package main
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+// Result is the JSON result for a single input.
+//
+// Valid is always present, and is true exactly when ethaddr.Parse accepts the input.
+// (Note that ethaddr.Parse does not require a valid EIP-55 / ERC-55 checksum — see Checksum for that.)
+//
+// Which of the other fields are present depends on the endpoint.
+//
+// Input is left empty for a from-bigint input that has too many digits (rather than echoing back something arbitrarily long).
+type Result struct {
+	Input    string `json:"input"`
+	Valid    bool   `json:"valid"`
+	Error    string `json:"error,omitempty"`
+	EIP55    string `json:"eip55,omitempty"`
+	Checksum string `json:"checksum,omitempty"`
+	BigInt   string `json:"bigint,omitempty"`
+	Kind     string `json:"kind,omitempty"`
+}
+
+// These are the values of Result.Checksum.
+const (
+	checksumValid     = "valid"     // mixed-case, with a valid EIP-55 / ERC-55 checksum
+	checksumLowerCase = "lowercase" // no checksum
+	checksumUpperCase = "uppercase" // no checksum
+	checksumInvalid   = "invalid"   // mixed-case, with an invalid EIP-55 / ERC-55 checksum
+)
+
+// operation turns a single input into a single result.
+type operation func(input string) Result
+
+var operations = map[string]operation{
+	"parse":       operationParse,
+	"validate":    operationValidate,
+	"checksum":    operationChecksum,
+	"bigint":      operationBigInt,
+	"from-bigint": operationFromBigInt,
+	"kind":        operationKind,
+}
+
+// checksumStatus returns the EIP-55 / ERC-55 checksum status of 'input', which must already have been parsed successfully.
+//
+// This uses ethaddr.HasValidChecksum rather than ethaddr.Diagnose, since Diagnose also works out suggestions, which is too expensive to do for every input.
+func checksumStatus(input string) string {
+	var hex string = strings.TrimPrefix(input, "0x")
+	switch {
+	case strings.ToLower(hex) == hex:
+		return checksumLowerCase
+	case strings.ToUpper(hex) == hex:
+		return checksumUpperCase
+	case ethaddr.HasValidChecksum(input):
+		return checksumValid
+	default:
+		return checksumInvalid
+	}
+}
+
+func operationParse(input string) Result {
+	address, err := ethaddr.ParseString(input)
+	if nil != err {
+		return Result{Input: input, Error: err.Error()}
+	}
+
+	return Result{
+		Input:    input,
+		Valid:    true,
+		EIP55:    address.EIP55(),
+		Checksum: checksumStatus(input),
+		BigInt:   address.BigInt().String(),
+		Kind:     address.Kind().String(),
+	}
+}
+
+func operationValidate(input string) Result {
+	_, err := ethaddr.ParseString(input)
+	if nil != err {
+		return Result{Input: input, Error: err.Error()}
+	}
+
+	return Result{
+		Input:    input,
+		Valid:    true,
+		Checksum: checksumStatus(input),
+	}
+}
+
+func operationChecksum(input string) Result {
+	address, err := ethaddr.ParseString(input)
+	if nil != err {
+		return Result{Input: input, Error: err.Error()}
+	}
+
+	return Result{
+		Input: input,
+		Valid: true,
+		EIP55: address.EIP55(),
+	}
+}
+
+func operationBigInt(input string) Result {
+	address, err := ethaddr.ParseString(input)
+	if nil != err {
+		return Result{Input: input, Error: err.Error()}
+	}
+
+	return Result{
+		Input:  input,
+		Valid:  true,
+		BigInt: address.BigInt().String(),
+	}
+}
+
+// maxBigIntDigits is the most decimal digits an eth-address (as a 160-bit unsigned integer) can have.
+// (2^160 - 1 = 1461501637330902918203684832716283019655932542975)
+const maxBigIntDigits = 49
+
+func operationFromBigInt(input string) Result {
+	// Longer input is rejected before it is parsed (and is not echoed back), since it could be arbitrarily long.
+	if maxBigIntDigits < len(input) {
+		return Result{Error: "too many digits"}
+	}
+
+	bigint, ok := new(big.Int).SetString(input, 10)
+	if !ok {
+		return Result{Input: input, Error: "not a decimal integer"}
+	}
+
+	address, err := ethaddr.BigInt(bigint)
+	if nil != err {
+		return Result{Input: input, Error: err.Error()}
+	}
+
+	return Result{
+		Input:  input,
+		Valid:  true,
+		EIP55:  address.EIP55(),
+		BigInt: address.BigInt().String(),
+	}
+}
+
+func operationKind(input string) Result {
+	address, err := ethaddr.ParseString(input)
+	if nil != err {
+		return Result{Input: input, Error: err.Error()}
+	}
+
+	return Result{
+		Input: input,
+		Valid: true,
+		Kind:  address.Kind().String(),
+	}
+}
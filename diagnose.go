@@ -0,0 +1,204 @@
+package ethaddr
+
+import (
+	"bytes"
+
+	"github.com/reiver/go-eip55"
+)
+
+// Mistake is the kind of (single) mistake that a Suggestion corrects.
+type Mistake int
+
+const (
+	MistakeSubstitution Mistake = iota + 1 // a single hexadecimal-symbol was replaced by another (including just its letter-case being wrong)
+	MistakeTransposition                   // two adjacent hexadecimal-symbols were swapped
+	MistakeDropped                         // a single hexadecimal-symbol was left out
+	MistakeExtra                           // a single extra hexadecimal-symbol was added
+)
+
+// String returns the name of the mistake.
+func (receiver Mistake) String() string {
+	switch receiver {
+	case MistakeSubstitution:
+		return "substitution"
+	case MistakeTransposition:
+		return "transposition"
+	case MistakeDropped:
+		return "dropped"
+	case MistakeExtra:
+		return "extra"
+	default:
+		return "unknown"
+	}
+}
+
+// Suggestion is what Diagnose thinks the user probably meant.
+//
+// Position is the byte-index into the (original) text (including the "0x" prefix) where the mistake is.
+type Suggestion struct {
+	Address  Address
+	Mistake  Mistake
+	Position int
+}
+
+// Diagnose checks the hexadecimal-literal in 'text', and if it is not valid, suggests what was probably meant.
+//
+// If 'text' is a valid eth-address, then Diagnose returns nil (for both return values).
+// A valid eth-address is one that Parse accepts, that has exactly 40 hexadecimal-symbols, AND (if it is mixed-case) has a valid EIP-55 / ERC-55 checksum.
+// (An all lower-case or all upper-case hexadecimal-literal does not have a checksum.)
+//
+// Although Parse accepts a hexadecimal-literal with 39 hexadecimal-symbols, Diagnose always reports it as a dropped hexadecimal-symbol.
+// If it is mixed-case, then only the suggestions with a valid EIP-55 / ERC-55 checksum are returned.
+// If it is not mixed-case, then there is no checksum to narrow things down, so every (same-case) insertion of a hexadecimal-symbol is returned.
+//
+// If 'text' is not valid, then Diagnose returns an error, and also any eth-addresses, with a valid EIP-55 / ERC-55 checksum,
+// that are a single substitution, adjacent transposition, dropped hexadecimal-symbol, or extra hexadecimal-symbol away from 'text'.
+//
+// For example:
+//
+//	// Note that the last "D" should have been a "d".
+//	suggestions, err := ethaddr.Diagnose("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD")
+//
+// Since the EIP-55 / ERC-55 checksum is only present on mixed-case hexadecimal-literals, Diagnose usually can only make suggestions for those.
+func Diagnose(text string) ([]Suggestion, error) {
+	var address [AddressLength]byte
+
+	err := unmarshalText(&address, []byte(text))
+	if nil == err {
+		switch {
+		case AddressLength*2 - 1 == len(text) - len(hexlitprefix):
+			err = ErrDroppedHexadecimalSymbol
+		case hasValidChecksum(address, text):
+			return nil, nil
+		default:
+			err = ErrBadChecksum
+		}
+	}
+
+	if !bytes.HasPrefix([]byte(text), hexlitprefix[:]) {
+		return nil, err
+	}
+
+	// Each suggestion is a single mistake away, so only hexadecimal-literals with 39, 40, or 41 hexadecimal-symbols can have any.
+	// (Checking this first also keeps Diagnose cheap for long, untrusted, text.)
+	if numHex := len(text) - len(hexlitprefix); numHex < AddressLength*2 - 1 || AddressLength*2 + 1 < numHex {
+		return nil, err
+	}
+
+	return suggest(text), err
+}
+
+// HasValidChecksum returns true exactly when Diagnose would return a nil error for 'text' —
+// i.e., if 'text' is an eth-address that Parse accepts, that has exactly 40 hexadecimal-symbols, and that is either not mixed-case, or is mixed-case with a valid EIP-55 / ERC-55 checksum.
+//
+// Unlike Diagnose, HasValidChecksum does not work out any suggestions, so it is cheap to call on untrusted input.
+//
+// Note that an all lower-case or all upper-case hexadecimal-literal does not have a checksum, so HasValidChecksum returns true for it.
+func HasValidChecksum(text string) bool {
+	if AddressLength*2 != len(text) - len(hexlitprefix) {
+		return false
+	}
+
+	var address [AddressLength]byte
+	if err := unmarshalText(&address, []byte(text)); nil != err {
+		return false
+	}
+
+	return hasValidChecksum(address, text)
+}
+
+// hasValidChecksum returns true if 'text' (which must already have been decoded into 'address', from 40 hexadecimal-symbols) is either not mixed-case, or is mixed-case with a valid EIP-55 / ERC-55 checksum.
+func hasValidChecksum(address [AddressLength]byte, text string) bool {
+	var hex string = text[len(hexlitprefix):]
+	if !isMixedCase(hex) {
+		return true
+	}
+
+	return eip55.Encode(address)[len(hexlitprefix):] == hex
+}
+
+// isMixedCase returns true if 'hex' has both lower-case and upper-case hexadecimal-symbols.
+func isMixedCase(hex string) bool {
+	var hasLower, hasUpper bool
+	for i:=0; i<len(hex); i++ {
+		switch b := hex[i]; {
+		case 'a' <= b && b <= 'f':
+			hasLower = true
+		case 'A' <= b && b <= 'F':
+			hasUpper = true
+		}
+	}
+	return hasLower && hasUpper
+}
+
+// suggest returns all the eth-addresses with a valid EIP-55 / ERC-55 checksum that are a single mistake away from 'text'.
+func suggest(text string) []Suggestion {
+	const symbols string = "0123456789abcdefABCDEF"
+
+	var hex string = text[len(hexlitprefix):]
+	var mixedCase bool = isMixedCase(hex)
+	var suggestions []Suggestion
+	var seen = map[Address]struct{}{}
+
+	try := func(candidate string, mistake Mistake, position int) {
+		const expected int = AddressLength*2
+		if expected != len(candidate) {
+			return
+		}
+
+		var address [AddressLength]byte
+		if err := unmarshalText(&address, []byte("0x"+candidate)); nil != err {
+			return
+		}
+		if eip55.Encode(address)[len(hexlitprefix):] != candidate {
+			// Without a checksum, a dropped hexadecimal-symbol is the only mistake that can be detected (from the length).
+			if mixedCase || MistakeDropped != mistake || isMixedCase(candidate) {
+				return
+			}
+		}
+
+		var suggestion Address = Something(address)
+		if _, found := seen[suggestion]; found {
+			return
+		}
+		seen[suggestion] = struct{}{}
+
+		suggestions = append(suggestions, Suggestion{
+			Address: suggestion,
+			Mistake: mistake,
+			Position: len(hexlitprefix) + position,
+		})
+	}
+
+	// substitution
+	for i:=0; i<len(hex); i++ {
+		for j:=0; j<len(symbols); j++ {
+			if symbols[j] == hex[i] {
+				continue
+			}
+			try(hex[:i]+symbols[j:j+1]+hex[i+1:], MistakeSubstitution, i)
+		}
+	}
+
+	// transposition
+	for i:=0; i+1<len(hex); i++ {
+		if hex[i] == hex[i+1] {
+			continue
+		}
+		try(hex[:i]+hex[i+1:i+2]+hex[i:i+1]+hex[i+2:], MistakeTransposition, i)
+	}
+
+	// dropped
+	for i:=0; i<=len(hex); i++ {
+		for j:=0; j<len(symbols); j++ {
+			try(hex[:i]+symbols[j:j+1]+hex[i:], MistakeDropped, i)
+		}
+	}
+
+	// extra
+	for i:=0; i<len(hex); i++ {
+		try(hex[:i]+hex[i+1:], MistakeExtra, i)
+	}
+
+	return suggestions
+}
@@ -0,0 +1,290 @@
+package ethaddr_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestDiagnose(t *testing.T) {
+
+	tests := []struct{
+		Text string
+	}{
+		{
+			Text: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		},
+		{
+			Text: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		},
+		{
+			Text: "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED",
+		},
+		{
+			Text: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		},
+		{
+			Text: "0x0000000000000000000000000000000000000000",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		suggestions, err := ethaddr.Diagnose(test.Text)
+
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("TEXT: %q", test.Text)
+			continue
+		}
+
+		if 0 != len(suggestions) {
+			t.Errorf("For test #%d, did not expect any suggestions but actually got %d.", testNumber, len(suggestions))
+			t.Logf("SUGGESTIONS: %#v", suggestions)
+			t.Logf("TEXT: %q", test.Text)
+			continue
+		}
+	}
+}
+
+func TestDiagnose_fail(t *testing.T) {
+
+	tests := []struct{
+		Text string
+		ExpectedError string
+		ExpectedSuggestions []ethaddr.Suggestion
+	}{
+		{
+			Text:          "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD",
+			ExpectedError: "ethaddr: bad EIP-55 / ERC-55 checksum",
+			ExpectedSuggestions: []ethaddr.Suggestion{
+				{
+					Address: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+					Mistake: ethaddr.MistakeSubstitution,
+					Position: 41,
+				},
+			},
+		},
+		{
+			Text:          "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d358",
+			ExpectedError: "ethaddr: bad EIP-55 / ERC-55 checksum",
+			ExpectedSuggestions: []ethaddr.Suggestion{
+				{
+					Address: ethaddr.ParseStringElsePanic("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"),
+					Mistake: ethaddr.MistakeSubstitution,
+					Position: 41,
+				},
+			},
+		},
+		{
+			Text:          "0x5aAeb6053F3E94C9b9A90f33669435E7Ef1BeAed",
+			ExpectedError: "ethaddr: bad EIP-55 / ERC-55 checksum",
+			ExpectedSuggestions: []ethaddr.Suggestion{
+				{
+					Address: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+					Mistake: ethaddr.MistakeTransposition,
+					Position: 21,
+				},
+			},
+		},
+		{
+			Text:          "0x5aAeb6053F3E94C9b9A09f3669435E7Ef1BeAed",
+			ExpectedError: "ethaddr: eth-address has 39 hexadecimal-symbols rather than 40 (a hexadecimal-symbol was probably dropped)",
+			ExpectedSuggestions: []ethaddr.Suggestion{
+				{
+					Address: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+					Mistake: ethaddr.MistakeDropped,
+					Position: 24,
+				},
+			},
+		},
+		{
+			Text:          "0x5aAeb6053F3E94C9b9A09f333669435E7Ef1BeAed",
+			ExpectedError: "ethaddr: the eth-address is expected to be 42 or 41 bytes long, but was actually 43 bytes long",
+			ExpectedSuggestions: []ethaddr.Suggestion{
+				{
+					Address: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+					Mistake: ethaddr.MistakeExtra,
+					Position: 24,
+				},
+			},
+		},
+		{
+			Text:          "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeG",
+			ExpectedError: "ethaddr: byte number-39 (after \"0x\" prefix) of hexadecimal literal (71) ('G') is not a valid hexadecimal symbol",
+			ExpectedSuggestions: []ethaddr.Suggestion{
+				{
+					Address: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+					Mistake: ethaddr.MistakeSubstitution,
+					Position: 41,
+				},
+			},
+		},
+		{
+			Text:          "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			ExpectedError: "ethaddr: missing prefix for hexadecimal-literal (i.e., \"0x\")",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		suggestions, err := ethaddr.Diagnose(test.Text)
+
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("TEXT: %q", test.Text)
+			continue
+		}
+
+		{
+			expected := test.ExpectedError
+			actual := err.Error()
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				t.Logf("TEXT: %q", test.Text)
+				continue
+			}
+		}
+
+		if expected, actual := len(test.ExpectedSuggestions), len(suggestions); expected != actual {
+			t.Errorf("For test #%d, the actual number of suggestions is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			t.Logf("SUGGESTIONS: %#v", suggestions)
+			t.Logf("TEXT: %q", test.Text)
+			continue
+		}
+
+		for suggestionNumber, expected := range test.ExpectedSuggestions {
+			actual := suggestions[suggestionNumber]
+
+			if expected != actual {
+				t.Errorf("For test #%d and suggestion #%d, the actual suggestion is not what was expected.", testNumber, suggestionNumber)
+				t.Logf("EXPECTED: %#v", expected)
+				t.Logf("ACTUAL:   %#v", actual)
+				t.Logf("TEXT: %q", test.Text)
+				continue
+			}
+		}
+	}
+}
+
+func TestDiagnose_droppedWithoutChecksum(t *testing.T) {
+
+	tests := []struct{
+		Text string
+		Expected ethaddr.Address
+	}{
+		{
+			Text:     "0x5aaeb6053f3e94c9b9a09f3669435e7ef1beaed",
+			Expected: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+		},
+		{
+			Text:     "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAE",
+			Expected: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+		},
+		{
+			Text:     "0x000000000000000000000000000000000000000",
+			Expected: ethaddr.Zero(),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		if _, err := ethaddr.ParseString(test.Text); nil != err {
+			t.Errorf("For test #%d, did not expect Parse to return an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("TEXT: %q", test.Text)
+			continue
+		}
+
+		suggestions, err := ethaddr.Diagnose(test.Text)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("TEXT: %q", test.Text)
+			continue
+		}
+
+		var found bool
+		for suggestionNumber, suggestion := range suggestions {
+			if ethaddr.MistakeDropped != suggestion.Mistake {
+				t.Errorf("For test #%d and suggestion #%d, expected the mistake to be %q but actually was %q.", testNumber, suggestionNumber, ethaddr.MistakeDropped, suggestion.Mistake)
+			}
+			if test.Expected == suggestion.Address {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("For test #%d, expected the suggestions to include %s, but they did not.", testNumber, test.Expected)
+			t.Logf("NUMBER-OF-SUGGESTIONS: %d", len(suggestions))
+			t.Logf("TEXT: %q", test.Text)
+			continue
+		}
+	}
+}
+
+func TestHasValidChecksum(t *testing.T) {
+
+	tests := []struct{
+		Text string
+		Expected bool
+	}{
+		{Text: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", Expected: true},
+		{Text: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", Expected: true},
+		{Text: "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", Expected: true},
+		{Text: "0x0000000000000000000000000000000000000000", Expected: true},
+
+		{Text: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", Expected: false},
+		{Text: "0x5aaeb6053f3e94c9b9a09f3669435e7ef1beaed",  Expected: false},
+		{Text: "0x5aAeb6053F3E94C9b9A09f333669435E7Ef1BeAed", Expected: false},
+		{Text: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeG", Expected: false},
+		{Text: "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",   Expected: false},
+		{Text: "",                                           Expected: false},
+	}
+
+	for testNumber, test := range tests {
+
+		if expected, actual := test.Expected, ethaddr.HasValidChecksum(test.Text); expected != actual {
+			t.Errorf("For test #%d, the actual result is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %t", expected)
+			t.Logf("ACTUAL:   %t", actual)
+			t.Logf("TEXT: %q", test.Text)
+			continue
+		}
+
+		// HasValidChecksum should agree with Diagnose.
+		if _, err := ethaddr.Diagnose(test.Text); test.Expected != (nil == err) {
+			t.Errorf("For test #%d, HasValidChecksum and Diagnose do not agree.", testNumber)
+			t.Logf("HAS-VALID-CHECKSUM: %t", test.Expected)
+			t.Logf("DIAGNOSE-ERROR: %v", err)
+			t.Logf("TEXT: %q", test.Text)
+			continue
+		}
+	}
+}
+
+// TestDiagnose_long checks that Diagnose is quick (and makes no suggestions) for long text.
+func TestDiagnose_long(t *testing.T) {
+
+	var text string = "0x" + strings.Repeat("aB", 32*1024)
+
+	var start time.Time = time.Now()
+	suggestions, err := ethaddr.Diagnose(text)
+	var elapsed time.Duration = time.Since(start)
+
+	if nil == err {
+		t.Errorf("Expected an error but did not actually get one.")
+	}
+	if 0 != len(suggestions) {
+		t.Errorf("Expected no suggestions, but actually got %d.", len(suggestions))
+	}
+	if time.Second < elapsed {
+		t.Errorf("Expected Diagnose to be quick, but it actually took %s.", elapsed)
+	}
+}
@@ -0,0 +1,33 @@
+package ethaddr
+
+import (
+	"os"
+
+	"github.com/reiver/go-erorr"
+)
+
+// FromEnv returns the eth-address stored in the environment-variable named 'name'.
+//
+// For example:
+//
+//	treasury, err := ethaddr.FromEnv("TREASURY_ADDRESS")
+//
+// The value of the environment-variable is parsed the same way Parse does.
+//
+// FromEnv returns an error (that names the environment-variable) if the environment-variable is not set, is empty, or cannot be parsed.
+func FromEnv(name string) (Address, error) {
+	value, found := os.LookupEnv(name)
+	if !found {
+		return Nothing(), erorr.Errorf("ethaddr: environment-variable %q is not set", name)
+	}
+	if "" == value {
+		return Nothing(), erorr.Errorf("ethaddr: environment-variable %q is empty", name)
+	}
+
+	address, err := ParseString(value)
+	if nil != err {
+		return Nothing(), erorr.Errorf("ethaddr: environment-variable %q does not contain a valid eth-address: %w", name, err)
+	}
+
+	return address, nil
+}
@@ -0,0 +1,71 @@
+package ethaddr_test
+
+import (
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestFromEnv(t *testing.T) {
+
+	t.Setenv("ETHADDR_TEST_TREASURY_ADDRESS", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+
+	actual, err := ethaddr.FromEnv("ETHADDR_TEST_TREASURY_ADDRESS")
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	if expected := ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"); expected != actual {
+		t.Errorf("The actual eth-address is not what was expected.")
+		t.Logf("EXPECTED: %#v", expected)
+		t.Logf("ACTUAL:   %#v", actual)
+		return
+	}
+}
+
+func TestFromEnv_fail(t *testing.T) {
+
+	t.Setenv("ETHADDR_TEST_EMPTY", "")
+	t.Setenv("ETHADDR_TEST_BAD", "0x5aAe")
+
+	tests := []struct{
+		Name string
+		ExpectedError string
+	}{
+		{
+			Name: "ETHADDR_TEST_NOT_SET",
+			ExpectedError: `ethaddr: environment-variable "ETHADDR_TEST_NOT_SET" is not set`,
+		},
+		{
+			Name: "ETHADDR_TEST_EMPTY",
+			ExpectedError: `ethaddr: environment-variable "ETHADDR_TEST_EMPTY" is empty`,
+		},
+		{
+			Name: "ETHADDR_TEST_BAD",
+			ExpectedError: `ethaddr: environment-variable "ETHADDR_TEST_BAD" does not contain a valid eth-address: `,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		address, err := ethaddr.FromEnv(test.Name)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			continue
+		}
+
+		if expected, actual := test.ExpectedError, err.Error(); len(actual) < len(expected) || expected != actual[:len(expected)] {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			continue
+		}
+
+		if !address.IsNothing() {
+			t.Errorf("For test #%d, expected nothing but actually got something.", testNumber)
+			continue
+		}
+	}
+}
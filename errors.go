@@ -5,9 +5,63 @@ import (
 )
 
 const (
-	errMissingHexadecimalLiteralPrefix = erorr.Error("ethaddr: missing prefix for hexadecimal-literal (i.e., \"0x\")")
+	errBSONMissingNul                  = erorr.Error("ethaddr: BSON string missing terminating NUL byte")
+	errBSONTruncated                   = erorr.Error("ethaddr: truncated BSON value")
+	errCBORIndefiniteLength            = erorr.Error("ethaddr: indefinite-length CBOR data-item not supported")
+	errCBORTruncated                   = erorr.Error("ethaddr: truncated CBOR data-item")
+	errEmptyData                       = erorr.Error("ethaddr: empty data")
 	errNilBigInt                       = erorr.Error("ethaddr: nil big-int")
 	errNilDestination                  = erorr.Error("ethaddr: nil destination")
 	errNilReceiver                     = erorr.Error("ethaddr: nil receiver")
+	errNilStruct                       = erorr.Error("ethaddr: nil struct")
+	errNilYAMLNode                     = erorr.Error("ethaddr: nil YAML node")
 	errNothing                         = erorr.Error("ethaddr: nothing")
+	errPrecompileAddress               = erorr.Error("ethaddr: eth-address must not be a precompile")
+	errRequired                        = erorr.Error("ethaddr: eth-address is required")
+	errZeroAddress                     = erorr.Error("ethaddr: eth-address must not be the zero eth-address")
 )
+
+// These are the kinds of error that Parse (and the other functions and methods that parse a hexadecimal-literal) return.
+//
+// Use errors.Is to check for them. For example:
+//
+//	address, err := ethaddr.ParseString(text)
+//	if errors.Is(err, ethaddr.ErrBadLength) {
+//		// ...
+//	}
+//
+// (The actual error returned might have a more detailed message than these.)
+const (
+	ErrBadHexadecimalSymbol = erorr.Error("ethaddr: bad hexadecimal symbol")
+	ErrBadLength            = erorr.Error("ethaddr: bad length")
+	ErrMissingPrefix        = erorr.Error("ethaddr: missing prefix for hexadecimal-literal (i.e., \"0x\")")
+)
+
+// These are the kinds of error that Diagnose (and HasValidChecksum) add on top of the ones that Parse returns.
+//
+// Use errors.Is to check for them.
+const (
+	ErrBadChecksum              = erorr.Error("ethaddr: bad EIP-55 / ERC-55 checksum")
+	ErrDroppedHexadecimalSymbol = erorr.Error("ethaddr: eth-address has 39 hexadecimal-symbols rather than 40 (a hexadecimal-symbol was probably dropped)")
+)
+
+// parseError is a parse error with a detailed message, that (for errors.Is) wraps one of the exported Err* errors.
+type parseError struct {
+	kind    error
+	message string
+}
+
+func newParseError(kind error, format string, a ...interface{}) error {
+	return parseError{
+		kind: kind,
+		message: erorr.Errorf(format, a...).Error(),
+	}
+}
+
+func (receiver parseError) Error() string {
+	return receiver.message
+}
+
+func (receiver parseError) Unwrap() error {
+	return receiver.kind
+}
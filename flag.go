@@ -0,0 +1,157 @@
+package ethaddr
+
+import (
+	"flag"
+	"strings"
+)
+
+// Flag defines an eth-address flag with the specified name, default value, and usage string, on the flag-set 'fs'.
+// The return value is the address of an Address variable that stores the value of the flag.
+//
+// If 'fs' is nil then flag.CommandLine is used.
+//
+// For example:
+//
+//	var to *ethaddr.Address = ethaddr.Flag(nil, "to", ethaddr.Nothing(), "the eth-address to send to")
+//
+// The value of the flag is parsed the same way Parse does.
+func Flag(fs *flag.FlagSet, name string, value Address, usage string) *Address {
+	var p *Address = new(Address)
+	Var(fs, p, name, value, usage)
+	return p
+}
+
+// Var defines an eth-address flag with the specified name, default value, and usage string, on the flag-set 'fs'.
+// The argument 'p' points to an Address variable in which to store the value of the flag.
+//
+// If 'fs' is nil then flag.CommandLine is used.
+//
+// For example:
+//
+//	var feeRecipient ethaddr.Address
+//	
+//	ethaddr.Var(nil, &feeRecipient, "fee-recipient", ethaddr.Nothing(), "the eth-address that receives fees")
+//
+// The value of the flag is parsed the same way Parse does.
+func Var(fs *flag.FlagSet, p *Address, name string, value Address, usage string) {
+	if nil == fs {
+		fs = flag.CommandLine
+	}
+	if nil == p {
+		panic(errNilDestination)
+	}
+
+	*p = value
+	fs.Var((*flagValue)(p), name, usage)
+}
+
+// flagValue is used by Flag and Var.
+//
+// (Address cannot implement flag.Getter itself, since Address already has a Get method with a different signature.)
+type flagValue Address
+
+var _ flag.Getter = &flagValue{}
+
+// Get makes it so flagValue implements flag.Getter.
+//
+// Get returns an Address.
+func (receiver *flagValue) Get() interface{} {
+	if nil == receiver {
+		return Nothing()
+	}
+
+	return Address(*receiver)
+}
+
+// Set makes it so flagValue implements flag.Value.
+func (receiver *flagValue) Set(value string) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	return (*Address)(receiver).UnmarshalText([]byte(value))
+}
+
+// String makes it so flagValue implements flag.Value.
+func (receiver *flagValue) String() string {
+	if nil == receiver {
+		return ""
+	}
+
+	return Address(*receiver).String()
+}
+
+// AddressList is a list of eth-addresses that can be used as a flag.
+//
+// The flag can be repeated, and each value can contain more than one (comma-separated) eth-address.
+// For example:
+//
+//	var allow ethaddr.AddressList
+//	
+//	flag.Var(&allow, "allow", "eth-addresses to allow")
+//
+// And then:
+//
+//	--allow=0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed,0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359 --allow=0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB
+//
+// Results in 3 eth-addresses in the list.
+type AddressList []Address
+
+var _ flag.Getter = &AddressList{}
+
+// Get makes it so AddressList implements flag.Getter.
+//
+// Get returns an AddressList.
+func (receiver *AddressList) Get() interface{} {
+	if nil == receiver {
+		return AddressList(nil)
+	}
+
+	return *receiver
+}
+
+// Set makes it so AddressList implements flag.Value.
+//
+// Set appends the (comma-separated) eth-addresses in 'value' to the list.
+// If any of them cannot be parsed, then none of them are appended.
+func (receiver *AddressList) Set(value string) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+
+	var list AddressList = *receiver
+
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+
+		var address Address
+		err := address.UnmarshalText([]byte(field))
+		if nil != err {
+			return err
+		}
+
+		list = append(list, address)
+	}
+
+	*receiver = list
+	return nil
+}
+
+// String makes it so AddressList implements flag.Value.
+//
+// String returns the EIP-55 / ERC-55 encoded eth-addresses separated by commas.
+func (receiver *AddressList) String() string {
+	if nil == receiver {
+		return ""
+	}
+
+	var builder strings.Builder
+	for index, address := range *receiver {
+		if 0 < index {
+			builder.WriteByte(',')
+		}
+		builder.WriteString(address.String())
+	}
+
+	return builder.String()
+}
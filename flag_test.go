@@ -0,0 +1,123 @@
+package ethaddr_test
+
+import (
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestFlag(t *testing.T) {
+
+	tests := []struct{
+		Arguments []string
+		Expected ethaddr.Address
+	}{
+		{
+			Arguments: []string{},
+			Expected: ethaddr.Dead(),
+		},
+		{
+			Arguments: []string{"--to=0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"},
+			Expected: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+		},
+		{
+			Arguments: []string{"-to", "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"},
+			Expected: ethaddr.ParseStringElsePanic("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"),
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var fs *flag.FlagSet = flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+
+		var to *ethaddr.Address = ethaddr.Flag(fs, "to", ethaddr.Dead(), "the eth-address to send to")
+
+		err := fs.Parse(test.Arguments)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("ARGUMENTS: %#v", test.Arguments)
+			continue
+		}
+
+		if expected, actual := test.Expected, *to; expected != actual {
+			t.Errorf("For test #%d, the actual value of the flag is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual)
+			t.Logf("ARGUMENTS: %#v", test.Arguments)
+			continue
+		}
+
+		getter, casted := fs.Lookup("to").Value.(flag.Getter)
+		if !casted {
+			t.Errorf("For test #%d, expected the flag value to be a flag.Getter but it was not.", testNumber)
+			continue
+		}
+		if expected, actual := interface{}(test.Expected), getter.Get(); expected != actual {
+			t.Errorf("For test #%d, the actual value from Get() is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual)
+			continue
+		}
+	}
+}
+
+func TestFlag_fail(t *testing.T) {
+
+	var fs *flag.FlagSet = flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	ethaddr.Flag(fs, "to", ethaddr.Nothing(), "the eth-address to send to")
+
+	err := fs.Parse([]string{"--to=5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"})
+	if nil == err {
+		t.Errorf("Expected an error but did not actually get one.")
+		return
+	}
+}
+
+func TestAddressList(t *testing.T) {
+
+	var fs *flag.FlagSet = flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	var list ethaddr.AddressList
+	fs.Var(&list, "allow", "eth-addresses to allow")
+
+	err := fs.Parse([]string{
+		"--allow=0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed, 0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+		"--allow", "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+	})
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	{
+		expected := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed,0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359,0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"
+		actual := list.String()
+
+		if expected != actual {
+			t.Errorf("The actual value of the list is not what was expected.")
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			return
+		}
+	}
+
+	err = list.Set("0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb,0xnope")
+	if nil == err {
+		t.Errorf("Expected an error but did not actually get one.")
+		return
+	}
+	if expected, actual := 3, len(list); expected != actual {
+		t.Errorf("The actual length of the list is not what was expected after a failed Set.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+		return
+	}
+}
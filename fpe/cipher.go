@@ -0,0 +1,94 @@
+package fpe
+
+import (
+	"github.com/reiver/go-ethaddr"
+)
+
+// addressRadix and addressLength are how an eth-address is represented for FF1 — 40 hexadecimal numerals (i.e., 160 bits).
+const (
+	addressRadix  = 16
+	addressLength = ethaddr.AddressLength * 2
+)
+
+// Cipher encrypts eth-addresses into other (well-formed) eth-addresses, with a key, in a way that can be reversed (with the same key).
+//
+// It uses FF1 (from NIST SP 800-38G) with AES, over the 40 hexadecimal numerals of the eth-address.
+// So each key (and tweak) is a bijection on the set of all 2¹⁶⁰ eth-addresses.
+//
+// This is meant for making realistic test data from real data — for example:
+//
+//	cipher, err := fpe.New(key, []byte("staging"))
+//	
+//	fake := cipher.Encrypt(real)
+//	
+//	// ...
+//	
+//	real = cipher.Decrypt(fake)
+//
+// Note that, as with any deterministic encryption, the same eth-address always encrypts to the same eth-address (for the same key and tweak).
+//
+// Note that an encrypted eth-address will almost never be special (such as the zero eth-address, or a precompile) even if the original was.
+type Cipher struct {
+	ff1   ff1
+	tweak []byte
+}
+
+// New returns a new Cipher.
+//
+// 'key' is an AES key, and so must be 16, 24, or 32 bytes long.
+// 'tweak' is not secret, can be any length (including empty), and makes the Cipher a different bijection — for example, for each environment.
+func New(key []byte, tweak []byte) (*Cipher, error) {
+	f, err := newFF1(key, addressRadix)
+	if nil != err {
+		return nil, err
+	}
+
+	return &Cipher{
+		ff1:   f,
+		tweak: append([]byte(nil), tweak...),
+	}, nil
+}
+
+// Encrypt returns the encrypted eth-address.
+//
+// Nothing() encrypts to Nothing().
+func (receiver *Cipher) Encrypt(address ethaddr.Address) ethaddr.Address {
+	return receiver.crypt(address, false)
+}
+
+// Decrypt returns the decrypted eth-address.
+//
+// Nothing() decrypts to Nothing().
+func (receiver *Cipher) Decrypt(address ethaddr.Address) ethaddr.Address {
+	return receiver.crypt(address, true)
+}
+
+func (receiver *Cipher) crypt(address ethaddr.Address, decrypt bool) ethaddr.Address {
+	if nil == receiver {
+		panic(errNilCipher)
+	}
+
+	value, something := address.Get()
+	if !something {
+		return ethaddr.Nothing()
+	}
+
+	var numerals [addressLength]uint16
+	for index, b := range value {
+		numerals[2*index] = uint16(b >> 4)
+		numerals[2*index+1] = uint16(b & 0x0F)
+	}
+
+	result, err := receiver.ff1.crypt(numerals[:], receiver.tweak, decrypt)
+	if nil != err {
+		// This cannot happen, since every numeral is a hexadecimal numeral, and 16⁴⁰ is far more than 1000000.
+		panic(err)
+	}
+
+	var crypted [ethaddr.AddressLength]byte
+	for index := range crypted {
+		crypted[index] = byte(result[2*index]<<4) | byte(result[2*index+1])
+	}
+
+	return ethaddr.Something(crypted)
+}
@@ -0,0 +1,106 @@
+package fpe_test
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+	"github.com/reiver/go-ethaddr/fpe"
+)
+
+// TestCipher_knownAnswer checks known answers for eth-addresses.
+//
+// (The FF1 implementation itself is checked against the NIST samples in ff1_test.go.)
+func TestCipher_knownAnswer(t *testing.T) {
+
+	key, _ := hex.DecodeString("2B7E151628AED2A6ABF7158809CF4F3C")
+
+	tests := []struct{
+		Tweak string
+		PlainText string
+		CipherText string
+	}{
+		{Tweak: "",        PlainText: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", CipherText: "0x07141b972eE5388e4a3FFBc3903FeCCAC8443bC4"},
+		{Tweak: "",        PlainText: "0x0000000000000000000000000000000000000000", CipherText: "0x56802A82d83e6304060091e52414143D3cE8246A"},
+		{Tweak: "",        PlainText: "0x000000000000000000000000000000000000dEaD", CipherText: "0xF24C775E314E99Cb87F862c158c8DD6EdDa04CD7"},
+		{Tweak: "staging", PlainText: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", CipherText: "0xD700c40178b2795a584F81C21BBDE8766D420fB5"},
+		{Tweak: "staging", PlainText: "0x0000000000000000000000000000000000000000", CipherText: "0xA549eB7dA95Dd9199EbF3C27Dc67f676859D32BD"},
+		{Tweak: "staging", PlainText: "0x000000000000000000000000000000000000dEaD", CipherText: "0xf6486a7E67da8b0b953c923914470b672dabB431"},
+	}
+
+	for testNumber, test := range tests {
+
+		cipher, err := fpe.New(key, []byte(test.Tweak))
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		var plainText ethaddr.Address = ethaddr.ParseStringElsePanic(test.PlainText)
+		var cipherText ethaddr.Address = ethaddr.ParseStringElsePanic(test.CipherText)
+
+		if expected, actual := cipherText, cipher.Encrypt(plainText); expected != actual {
+			t.Errorf("For test #%d, the actual encrypted eth-address is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+
+		if expected, actual := plainText, cipher.Decrypt(cipherText); expected != actual {
+			t.Errorf("For test #%d, the actual decrypted eth-address is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+	}
+}
+
+func TestCipher_roundTrip(t *testing.T) {
+
+	var randomness = rand.New(rand.NewSource(1))
+
+	var key [32]byte
+	randomness.Read(key[:])
+
+	cipher, err := fpe.New(key[:], nil)
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: %s", err)
+	}
+
+	var seen = map[ethaddr.Address]ethaddr.Address{}
+
+	for i:=0; i<1000; i++ {
+		var value [ethaddr.AddressLength]byte
+		randomness.Read(value[:])
+
+		var address ethaddr.Address = ethaddr.Something(value)
+
+		var encrypted ethaddr.Address = cipher.Encrypt(address)
+		if other, found := seen[encrypted]; found && other != address {
+			t.Fatalf("Two eth-addresses (%s and %s) encrypted to the same eth-address (%s).", other, address, encrypted)
+		}
+		seen[encrypted] = address
+
+		if expected, actual := address, cipher.Decrypt(encrypted); expected != actual {
+			t.Fatalf("For iteration #%d, the actual decrypted eth-address is not what was expected. EXPECTED: %s ACTUAL: %s", i, expected, actual)
+		}
+	}
+
+	if !cipher.Encrypt(ethaddr.Nothing()).IsNothing() {
+		t.Errorf("Expected nothing to encrypt to nothing.")
+	}
+	if !cipher.Decrypt(ethaddr.Nothing()).IsNothing() {
+		t.Errorf("Expected nothing to decrypt to nothing.")
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+
+	_, err := fpe.New([]byte("too short"), nil)
+	if nil == err {
+		t.Errorf("Expected an error but did not actually get one.")
+		return
+	}
+}
@@ -0,0 +1,12 @@
+package fpe
+
+import (
+	"github.com/reiver/go-erorr"
+)
+
+const (
+	errBadRadix        = erorr.Error("fpe: radix must be between 2 and 65536")
+	errBadNumeral      = erorr.Error("fpe: numeral not less than radix")
+	errDomainTooSmall  = erorr.Error("fpe: domain too small — radix to the power of the length must be at least 1000000")
+	errNilCipher       = erorr.Error("fpe: nil cipher")
+)
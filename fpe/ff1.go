@@ -0,0 +1,175 @@
+package fpe
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/reiver/go-erorr"
+)
+
+// ff1 is the FF1 format-preserving encryption mode, from NIST SP 800-38G.
+//
+// See: https://doi.org/10.6028/NIST.SP.800-38G
+type ff1 struct {
+	block cipher.Block
+	radix uint32
+}
+
+func newFF1(key []byte, radix uint32) (ff1, error) {
+	if radix < 2 || 1<<16 < radix {
+		return ff1{}, errBadRadix
+	}
+
+	block, err := aes.NewCipher(key)
+	if nil != err {
+		return ff1{}, erorr.Errorf("fpe: %w", err)
+	}
+
+	return ff1{block: block, radix: radix}, nil
+}
+
+// prf is the PRF function of FF1 — i.e., AES CBC-MAC (with a zero IV).
+//
+// len(data) must be a multiple of 16.
+func (receiver ff1) prf(data []byte) [aes.BlockSize]byte {
+	var y [aes.BlockSize]byte
+	for i:=0; i<len(data); i+=aes.BlockSize {
+		for j:=0; j<aes.BlockSize; j++ {
+			y[j] ^= data[i+j]
+		}
+		receiver.block.Encrypt(y[:], y[:])
+	}
+
+	return y
+}
+
+// num returns the number represented by the numerals (most significant first) in base 'radix'.
+func (receiver ff1) num(numerals []uint16) *big.Int {
+	var radix *big.Int = big.NewInt(int64(receiver.radix))
+
+	var n *big.Int = new(big.Int)
+	for _, numeral := range numerals {
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(numeral)))
+	}
+
+	return n
+}
+
+// str writes 'n' as len(dst) numerals (most significant first) in base 'radix' into dst.
+func (receiver ff1) str(dst []uint16, n *big.Int) {
+	var radix *big.Int = big.NewInt(int64(receiver.radix))
+
+	var x *big.Int = new(big.Int).Set(n)
+	var r *big.Int = new(big.Int)
+	for i:=len(dst)-1; 0<=i; i-- {
+		x.QuoRem(x, radix, r)
+		dst[i] = uint16(r.Uint64())
+	}
+}
+
+// round returns the y of a round of FF1.
+func (receiver ff1) round(p []byte, tweak []byte, i int, numerals []uint16, b int, d int) *big.Int {
+	// Q = T || [0]^((−t−b−1) mod 16) || [i]^1 || [NUM(numerals)]^b
+	var padding int = ((-len(tweak)-b-1)%16 + 16) % 16
+
+	var q []byte = make([]byte, 0, len(tweak)+padding+1+b)
+	q = append(q, tweak...)
+	q = append(q, make([]byte, padding)...)
+	q = append(q, byte(i))
+	q = append(q, receiver.num(numerals).FillBytes(make([]byte, b))...)
+
+	var r [aes.BlockSize]byte = receiver.prf(append(append([]byte{}, p...), q...))
+
+	// S = the first d bytes of R || CIPH(R ⊕ [1]^16) || CIPH(R ⊕ [2]^16) || ...
+	var s []byte = append([]byte{}, r[:]...)
+	for j:=1; len(s) < d; j++ {
+		var x [aes.BlockSize]byte = r
+		var counter [aes.BlockSize]byte
+		binary.BigEndian.PutUint64(counter[8:], uint64(j))
+		for k := range x {
+			x[k] ^= counter[k]
+		}
+		receiver.block.Encrypt(x[:], x[:])
+		s = append(s, x[:]...)
+	}
+
+	return new(big.Int).SetBytes(s[:d])
+}
+
+// crypt encrypts (or decrypts) the numerals 'x' with the tweak.
+func (receiver ff1) crypt(x []uint16, tweak []byte, decrypt bool) ([]uint16, error) {
+	var n int = len(x)
+	var u int = n / 2
+	var v int = n - u
+
+	for _, numeral := range x {
+		if receiver.radix <= uint32(numeral) {
+			return nil, errBadNumeral
+		}
+	}
+	if new(big.Int).Exp(big.NewInt(int64(receiver.radix)), big.NewInt(int64(n)), nil).Cmp(big.NewInt(1000000)) < 0 {
+		return nil, errDomainTooSmall
+	}
+
+	var a []uint16 = append([]uint16{}, x[:u]...)
+	var b []uint16 = append([]uint16{}, x[u:]...)
+
+	// b = ⌈⌈v·LOG2(radix)⌉/8⌉, computed exactly as the byte-length of radix^v − 1.
+	var radix *big.Int = big.NewInt(int64(receiver.radix))
+	var bLength int = (new(big.Int).Sub(new(big.Int).Exp(radix, big.NewInt(int64(v)), nil), big.NewInt(1)).BitLen() + 7) / 8
+	var d int = 4*((bLength+3)/4) + 4
+
+	// P = [1]^1 || [2]^1 || [1]^1 || [radix]^3 || [10]^1 || [u mod 256]^1 || [n]^4 || [t]^4
+	var p [16]byte
+	p[0], p[1], p[2] = 1, 2, 1
+	p[3], p[4], p[5] = byte(receiver.radix>>16), byte(receiver.radix>>8), byte(receiver.radix)
+	p[6] = 10
+	p[7] = byte(u % 256)
+	binary.BigEndian.PutUint32(p[8:12], uint32(n))
+	binary.BigEndian.PutUint32(p[12:16], uint32(len(tweak)))
+
+	var radixU *big.Int = new(big.Int).Exp(radix, big.NewInt(int64(u)), nil)
+	var radixV *big.Int = new(big.Int).Exp(radix, big.NewInt(int64(v)), nil)
+
+	modulus := func(i int) (*big.Int, int) {
+		if 0 == i%2 {
+			return radixU, u
+		}
+		return radixV, v
+	}
+
+	if !decrypt {
+		for i:=0; i<10; i++ {
+			var y *big.Int = receiver.round(p[:], tweak, i, b, bLength, d)
+			mod, m := modulus(i)
+
+			var c *big.Int = receiver.num(a)
+			c.Add(c, y)
+			c.Mod(c, mod)
+
+			var cNumerals []uint16 = make([]uint16, m)
+			receiver.str(cNumerals, c)
+
+			a, b = b, cNumerals
+		}
+	} else {
+		for i:=9; 0<=i; i-- {
+			var y *big.Int = receiver.round(p[:], tweak, i, a, bLength, d)
+			mod, m := modulus(i)
+
+			var c *big.Int = receiver.num(b)
+			c.Sub(c, y)
+			c.Mod(c, mod)
+
+			var cNumerals []uint16 = make([]uint16, m)
+			receiver.str(cNumerals, c)
+
+			b, a = a, cNumerals
+		}
+	}
+
+	return append(a, b...), nil
+}
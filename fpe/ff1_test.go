@@ -0,0 +1,93 @@
+package fpe
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// TestFF1 uses the FF1 samples from NIST.
+//
+// See: https://csrc.nist.gov/CSRC/media/Projects/Cryptographic-Standards-and-Guidelines/documents/examples/FF1samples.pdf
+func TestFF1(t *testing.T) {
+
+	const key128 = "2B7E151628AED2A6ABF7158809CF4F3C"
+	const key192 = "2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F"
+	const key256 = "2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F7F036D6F04FC6A94"
+
+	tests := []struct{
+		Key string
+		Radix uint32
+		Tweak string
+		PlainText string
+		CipherText string
+	}{
+		{Key: key128, Radix: 10, Tweak: "",                       PlainText: "0123456789",          CipherText: "2433477484"},
+		{Key: key128, Radix: 10, Tweak: "39383736353433323130",   PlainText: "0123456789",          CipherText: "6124200773"},
+		{Key: key128, Radix: 36, Tweak: "3737373770717273373737", PlainText: "0123456789abcdefghi", CipherText: "a9tv40mll9kdu509eum"},
+		{Key: key192, Radix: 10, Tweak: "",                       PlainText: "0123456789",          CipherText: "2830668132"},
+		{Key: key192, Radix: 10, Tweak: "39383736353433323130",   PlainText: "0123456789",          CipherText: "2496655549"},
+		{Key: key192, Radix: 36, Tweak: "3737373770717273373737", PlainText: "0123456789abcdefghi", CipherText: "xbj3kv35jrawxv32ysr"},
+		{Key: key256, Radix: 10, Tweak: "",                       PlainText: "0123456789",          CipherText: "6657667009"},
+		{Key: key256, Radix: 10, Tweak: "39383736353433323130",   PlainText: "0123456789",          CipherText: "1001623463"},
+		{Key: key256, Radix: 36, Tweak: "3737373770717273373737", PlainText: "0123456789abcdefghi", CipherText: "xs8a0azh2avyalyzuwd"},
+	}
+
+	const alphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+	toNumerals := func(s string) []uint16 {
+		var numerals []uint16
+		for _, r := range s {
+			numerals = append(numerals, uint16(strings.IndexRune(alphabet, r)))
+		}
+		return numerals
+	}
+	fromNumerals := func(numerals []uint16) string {
+		var builder strings.Builder
+		for _, numeral := range numerals {
+			builder.WriteByte(alphabet[numeral])
+		}
+		return builder.String()
+	}
+
+	for testNumber, test := range tests {
+
+		key, _ := hex.DecodeString(test.Key)
+		tweak, _ := hex.DecodeString(test.Tweak)
+
+		f, err := newFF1(key, test.Radix)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		encrypted, err := f.crypt(toNumerals(test.PlainText), tweak, false)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error when encrypting but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		if expected, actual := test.CipherText, fromNumerals(encrypted); expected != actual {
+			t.Errorf("For test #%d, the actual cipher-text is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+
+		decrypted, err := f.crypt(encrypted, tweak, true)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error when decrypting but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			continue
+		}
+
+		if expected, actual := test.PlainText, fromNumerals(decrypted); expected != actual {
+			t.Errorf("For test #%d, the actual decrypted plain-text is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+	}
+}
@@ -3,13 +3,18 @@ module github.com/reiver/go-ethaddr
 go 1.22.0
 
 require (
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.4.1
 	github.com/reiver/go-eip55 v0.0.0-20240527041653-83d5e9e05714
 	github.com/reiver/go-erorr v0.0.0-20240704145350-0485e21eaa82
 	github.com/reiver/go-hexadeca v0.0.0-20240725113345-a1b13871efc1
 	github.com/reiver/go-opt v0.0.0-20240704165441-4ce81358adfc
+	golang.org/x/crypto v0.22.0
+	golang.org/x/tools v0.28.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
-	golang.org/x/crypto v0.22.0 // indirect
-	golang.org/x/sys v0.19.0 // indirect
+	golang.org/x/mod v0.22.0 // indirect
+	golang.org/x/sync v0.10.0 // indirect
+	golang.org/x/sys v0.28.0 // indirect
 )
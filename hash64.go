@@ -0,0 +1,38 @@
+package ethaddr
+
+import (
+	"encoding/binary"
+)
+
+// Hash64 returns a 64-bit hash of the eth-address, with a seed.
+//
+// Hash64 is meant for partitioning (such as with Sharder), and hash-tables.
+// Unlike hash/maphash, the result is the same in every process (for the same seed), and will not change in later versions of this package.
+//
+// Every bit of the eth-address affects every bit of the hash, so eth-addresses that share a long prefix
+// (such as vanity eth-addresses, and precompiles) are spread out just as well as any others.
+//
+// Hash64 is NOT a cryptographic hash.
+//
+// Nothing() has its own hash (for each seed), which is (almost certainly) different from the hash of every eth-address.
+func (receiver Address) Hash64(seed uint64) uint64 {
+	value, something := receiver.optional.Get()
+	if !something {
+		return mix64(seed ^ 0x9E3779B97F4A7C15)
+	}
+
+	var h uint64 = mix64(seed ^ 0x9E3779B97F4A7C15 ^ AddressLength)
+	h = mix64(h ^ binary.LittleEndian.Uint64(value[0:8]))
+	h = mix64(h ^ binary.LittleEndian.Uint64(value[8:16]))
+	h = mix64(h ^ uint64(binary.LittleEndian.Uint32(value[16:20])))
+
+	return h
+}
+
+// mix64 is the finalizer of SplitMix64 — a bijection on uint64 with good avalanche.
+func mix64(x uint64) uint64 {
+	x += 0x9E3779B97F4A7C15
+	x = (x ^ (x >> 30)) * 0xBF58476D1CE4E5B9
+	x = (x ^ (x >> 27)) * 0x94D049BB133111EB
+	return x ^ (x >> 31)
+}
@@ -0,0 +1,60 @@
+package ethaddr_test
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestAddress_Hash64(t *testing.T) {
+
+	tests := []struct{
+		Address ethaddr.Address
+		Seed uint64
+		Expected uint64
+	}{
+		{Address: ethaddr.Nothing(), Seed: 0,  Expected: 0x6e789e6aa1b965f4},
+		{Address: ethaddr.Nothing(), Seed: 42, Expected: 0x28efe333b266f103},
+		{Address: ethaddr.Zero(),    Seed: 0,  Expected: 0x86e154890d7bcba3},
+		{Address: ethaddr.Zero(),    Seed: 42, Expected: 0x6fd88eb3c4fdf338},
+		{Address: ethaddr.Dead(),    Seed: 0,  Expected: 0x9b3bebf5d9f8e688},
+		{Address: ethaddr.Dead(),    Seed: 42, Expected: 0xad06e05813e83845},
+		{Address: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"), Seed: 0,  Expected: 0xa0de00982f756e4a},
+		{Address: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"), Seed: 42, Expected: 0x6d58986fdf0f8848},
+	}
+
+	for testNumber, test := range tests {
+		if expected, actual := test.Expected, test.Address.Hash64(test.Seed); expected != actual {
+			t.Errorf("For test #%d, the actual hash is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#x", expected)
+			t.Logf("ACTUAL:   %#x", actual)
+			t.Logf("ADDRESS: %#v", test.Address)
+			t.Logf("SEED: %d", test.Seed)
+			continue
+		}
+	}
+}
+
+// TestAddress_Hash64_avalanche checks that flipping any single bit of an eth-address flips about half of the bits of the hash.
+func TestAddress_Hash64_avalanche(t *testing.T) {
+
+	var value [ethaddr.AddressLength]byte
+	var base uint64 = ethaddr.Something(value).Hash64(0)
+
+	var total int
+	for i:=0; i<ethaddr.AddressLength*8; i++ {
+		var flipped [ethaddr.AddressLength]byte = value
+		flipped[i/8] ^= 1 << (i%8)
+
+		var changed int = bits.OnesCount64(base ^ ethaddr.Something(flipped).Hash64(0))
+		if changed < 16 || 48 < changed {
+			t.Errorf("Flipping bit #%d of the eth-address flipped %d bits of the hash.", i, changed)
+		}
+		total += changed
+	}
+
+	if average := float64(total) / (ethaddr.AddressLength*8); average < 30 || 34 < average {
+		t.Errorf("The average number of flipped bits (%f) is too far from 32.", average)
+	}
+}
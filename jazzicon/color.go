@@ -0,0 +1,151 @@
+package jazzicon
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// palette is the (JavaScript) jazzicon library's colors.
+var palette = [...]string{
+	"#01888C", // teal
+	"#FC7500", // bright orange
+	"#034F5D", // dark teal
+	"#F73F01", // orangered
+	"#FC1960", // magenta
+	"#C7144C", // raspberry
+	"#F3C100", // goldenrod
+	"#1598F2", // lightning blue
+	"#2465E1", // sail blue
+	"#F19E02", // gold
+}
+
+// jsRound rounds the same way JavaScript's Math.round does (i.e., halves are rounded up).
+func jsRound(f float64) float64 {
+	return math.Floor(f + 0.5)
+}
+
+// rotateHue rotates the hue of the "#RRGGBB" color by 'degrees'.
+//
+// This mimics what the (JavaScript) color library (version 0.11) does, including its rounding of the intermediate HSL values.
+func rotateHue(hex string, degrees float64) string {
+	var rgb [3]float64
+	for i := range rgb {
+		value, _ := strconv.ParseUint(hex[1+i*2:3+i*2], 16, 8)
+		rgb[i] = float64(value)
+	}
+
+	var hsl [3]float64 = rgbToHSL(rgb)
+	for i := range hsl {
+		hsl[i] = jsRound(hsl[i])
+	}
+
+	{
+		var hue float64 = math.Mod(hsl[0] + degrees, 360)
+		if hue < 0 {
+			hue += 360
+		}
+		hsl[0] = jsRound(math.Min(hue, 360))
+	}
+
+	rgb = hslToRGB(hsl)
+
+	var builder strings.Builder
+	builder.WriteByte('#')
+	for _, value := range rgb {
+		var s string = strings.ToUpper(strconv.FormatUint(uint64(jsRound(value)), 16))
+		if len(s) < 2 {
+			builder.WriteByte('0')
+		}
+		builder.WriteString(s)
+	}
+
+	return builder.String()
+}
+
+func rgbToHSL(rgb [3]float64) [3]float64 {
+	var r float64 = rgb[0] / 255
+	var g float64 = rgb[1] / 255
+	var b float64 = rgb[2] / 255
+
+	var min float64 = math.Min(r, math.Min(g, b))
+	var max float64 = math.Max(r, math.Max(g, b))
+	var delta float64 = max - min
+
+	var h, s, l float64
+
+	switch {
+	case max == min:
+		h = 0
+	case r == max:
+		h = (g - b) / delta
+	case g == max:
+		h = 2 + (b - r) / delta
+	default:
+		h = 4 + (r - g) / delta
+	}
+
+	h = math.Min(h*60, 360)
+	if h < 0 {
+		h += 360
+	}
+
+	l = (min + max) / 2
+
+	switch {
+	case max == min:
+		s = 0
+	case l <= 0.5:
+		s = delta / (max + min)
+	default:
+		s = delta / (2 - max - min)
+	}
+
+	return [3]float64{h, s * 100, l * 100}
+}
+
+func hslToRGB(hsl [3]float64) [3]float64 {
+	var h float64 = hsl[0] / 360
+	var s float64 = hsl[1] / 100
+	var l float64 = hsl[2] / 100
+
+	if 0 == s {
+		var value float64 = l * 255
+		return [3]float64{value, value, value}
+	}
+
+	var t1, t2 float64
+	if l < 0.5 {
+		t2 = l * (1 + s)
+	} else {
+		t2 = l + s - l*s
+	}
+	t1 = 2*l - t2
+
+	var rgb [3]float64
+	for i := range rgb {
+		var t3 float64 = h + 1.0/3.0 * -float64(i-1)
+		if t3 < 0 {
+			t3++
+		}
+		if t3 > 1 {
+			t3--
+		}
+
+		var value float64
+		switch {
+		case 6*t3 < 1:
+			value = t1 + (t2-t1)*6*t3
+		case 2*t3 < 1:
+			value = t2
+		case 3*t3 < 2:
+			value = t1 + (t2-t1)*(2.0/3.0-t3)*6
+		default:
+			value = t1
+		}
+
+		rgb[i] = value * 255
+	}
+
+	return rgb
+}
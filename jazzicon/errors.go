@@ -0,0 +1,11 @@
+package jazzicon
+
+import (
+	"github.com/reiver/go-erorr"
+)
+
+const (
+	errBadDiameter = erorr.Error("jazzicon: diameter must be positive")
+	errBadWidth    = erorr.Error("jazzicon: width must be positive")
+	errNothing     = erorr.Error("jazzicon: eth-address is nothing")
+)
@@ -0,0 +1,240 @@
+package jazzicon
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+const (
+	shapeCount = 4
+	wobble     = 30
+)
+
+// Shape is one of the (rotated and translated) squares of a jazzicon.
+//
+// Rotate is in degrees, and (as with the JavaScript library) is rounded to 1 decimal place.
+type Shape struct {
+	TranslateX float64
+	TranslateY float64
+	Rotate     float64
+	Fill       string
+}
+
+// Jazzicon is the "jazzicon" avatar of an eth-address — the same one that MetaMask (and the JavaScript jazzicon library) shows.
+//
+// The avatar is a circle, Diameter across, filled with Background, with the Shapes drawn on top of it (in order).
+type Jazzicon struct {
+	Diameter   float64
+	Background string
+	Shapes     [shapeCount-1]Shape
+}
+
+// Generate returns the jazzicon for an eth-address.
+//
+// As MetaMask does, the seed is the first 4 bytes of the eth-address.
+//
+// For example:
+//
+//	icon, err := jazzicon.Generate(address, 32)
+//	
+//	svg := icon.SVG()
+func Generate(address ethaddr.Address, diameter float64) (Jazzicon, error) {
+	value, something := address.Get()
+	if !something {
+		return Jazzicon{}, errNothing
+	}
+	if diameter <= 0 {
+		return Jazzicon{}, errBadDiameter
+	}
+
+	var seed uint32 = uint32(value[0])<<24 | uint32(value[1])<<16 | uint32(value[2])<<8 | uint32(value[3])
+
+	return generate(seed, diameter), nil
+}
+
+func generate(seed uint32, diameter float64) Jazzicon {
+	var generator *mersenneTwister = newMersenneTwister(seed)
+
+	var remaining []string
+	{
+		var amount float64 = (generator.random() * 30) - (wobble / 2)
+
+		for _, hex := range palette {
+			remaining = append(remaining, rotateHue(hex, amount))
+		}
+	}
+
+	genColor := func() string {
+		// The JavaScript library throws away one random number here, so we do too.
+		generator.random()
+
+		var index int = int(float64(len(remaining)) * generator.random())
+		var color string = remaining[index]
+		remaining = append(remaining[:index], remaining[index+1:]...)
+
+		return color
+	}
+
+	var jazzicon = Jazzicon{
+		Diameter: diameter,
+		Background: genColor(),
+	}
+
+	const total = shapeCount - 1
+
+	for i := range jazzicon.Shapes {
+		var firstRot float64 = generator.random()
+		var angle float64 = math.Pi * 2 * firstRot
+		var velocity float64 = diameter / total * generator.random() + (float64(i) * diameter / total)
+
+		var secondRot float64 = generator.random()
+		var rot float64 = (firstRot * 360) + secondRot * 180
+		rot, _ = strconv.ParseFloat(toFixed1(rot), 64)
+
+		jazzicon.Shapes[i] = Shape{
+			TranslateX: cos(angle) * velocity,
+			TranslateY: sin(angle) * velocity,
+			Rotate: rot,
+			Fill: genColor(),
+		}
+	}
+
+	return jazzicon
+}
+
+// Transform returns the SVG transform of the shape, exactly as the JavaScript library writes it.
+func (receiver Shape) Transform(diameter float64) string {
+	var center string = formatNumber(diameter / 2)
+
+	return "translate(" + formatNumber(receiver.TranslateX) + " " + formatNumber(receiver.TranslateY) + ") rotate(" + toFixed1(receiver.Rotate) + " " + center + " " + center + ")"
+}
+
+// SVG returns the jazzicon as a (stand-alone) SVG image.
+//
+// The shapes are exactly what the JavaScript library produces.
+// (The JavaScript library puts the background color and the circular clipping on a surrounding HTML element — here they are part of the SVG.)
+func (receiver Jazzicon) SVG() string {
+	var diameter string = formatNumber(receiver.Diameter)
+	var radius   string = formatNumber(receiver.Diameter / 2)
+
+	// The clip-path only depends on the diameter, so if more than one jazzicon (of the same diameter) ends up in the same document, it does not matter whose clip-path gets used.
+	var clipID string = "jazzicon-clip-" + strings.ReplaceAll(diameter, ".", "_")
+
+	var builder strings.Builder
+
+	builder.WriteString(`<svg xmlns="http://www.w3.org/2000/svg" x="0" y="0" width="` + diameter + `" height="` + diameter + `">`)
+	builder.WriteString(`<clipPath id="` + clipID + `"><circle cx="` + radius + `" cy="` + radius + `" r="` + radius + `"/></clipPath>`)
+	builder.WriteString(`<g clip-path="url(#` + clipID + `)">`)
+	builder.WriteString(`<rect x="0" y="0" width="` + diameter + `" height="` + diameter + `" fill="` + receiver.Background + `"/>`)
+	for _, shape := range receiver.Shapes {
+		builder.WriteString(`<rect x="0" y="0" width="` + diameter + `" height="` + diameter + `" transform="` + shape.Transform(receiver.Diameter) + `" fill="` + shape.Fill + `"/>`)
+	}
+	builder.WriteString(`</g>`)
+	builder.WriteString(`</svg>`)
+
+	return builder.String()
+}
+
+// Image returns the jazzicon rasterized as an image, that is width×width pixels.
+//
+// Pixels outside of the circle are transparent.
+// Edges are anti-aliased (by super-sampling).
+//
+// If 'width' is not positive, then Image returns an error.
+func (receiver Jazzicon) Image(width int) (image.Image, error) {
+	const samples = 4
+
+	if width <= 0 {
+		return nil, errBadWidth
+	}
+
+	var img *image.RGBA = image.NewRGBA(image.Rect(0, 0, width, width))
+
+	var scale float64 = receiver.Diameter / float64(width)
+	var radius float64 = receiver.Diameter / 2
+
+	var background color.RGBA = parseHex(receiver.Background)
+	var fills [len(receiver.Shapes)]color.RGBA
+	for i, shape := range receiver.Shapes {
+		fills[i] = parseHex(shape.Fill)
+	}
+
+	for py:=0; py<width; py++ {
+		for px:=0; px<width; px++ {
+			var r, g, b, a float64
+
+			for sy:=0; sy<samples; sy++ {
+				for sx:=0; sx<samples; sx++ {
+					var x float64 = (float64(px) + (float64(sx)+0.5)/samples) * scale
+					var y float64 = (float64(py) + (float64(sy)+0.5)/samples) * scale
+
+					if (x-radius)*(x-radius) + (y-radius)*(y-radius) > radius*radius {
+						continue
+					}
+
+					var c color.RGBA = background
+					for i, shape := range receiver.Shapes {
+						if shape.contains(x, y, receiver.Diameter) {
+							c = fills[i]
+						}
+					}
+
+					r += float64(c.R)
+					g += float64(c.G)
+					b += float64(c.B)
+					a += 255
+				}
+			}
+
+			// (Samples outside of the circle contributed nothing, so this is already alpha-premultiplied.)
+			const n = samples * samples
+			img.SetRGBA(px, py, color.RGBA{
+				R: uint8(math.Round(r / n)),
+				G: uint8(math.Round(g / n)),
+				B: uint8(math.Round(b / n)),
+				A: uint8(math.Round(a / n)),
+			})
+		}
+	}
+
+	return img, nil
+}
+
+// contains returns true if the point (x, y) is inside the (transformed) shape.
+func (receiver Shape) contains(x float64, y float64, diameter float64) bool {
+	var center float64 = diameter / 2
+
+	// undo the translate.
+	x -= receiver.TranslateX
+	y -= receiver.TranslateY
+
+	// undo the rotate (around the center).
+	{
+		var theta float64 = -receiver.Rotate * math.Pi / 180
+		var cos, sin float64 = math.Cos(theta), math.Sin(theta)
+
+		var dx float64 = x - center
+		var dy float64 = y - center
+
+		x = center + dx*cos - dy*sin
+		y = center + dx*sin + dy*cos
+	}
+
+	return 0 <= x && x < diameter && 0 <= y && y < diameter
+}
+
+func parseHex(hex string) color.RGBA {
+	value, _ := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+
+	return color.RGBA{
+		R: uint8(value >> 16),
+		G: uint8(value >> 8),
+		B: uint8(value),
+		A: 0xFF,
+	}
+}
@@ -0,0 +1,209 @@
+package jazzicon_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+	"github.com/reiver/go-ethaddr/jazzicon"
+)
+
+// The expected values were produced by the (JavaScript) jazzicon library (with the mersenne-twister and color libraries it depends on).
+func TestGenerate(t *testing.T) {
+
+	tests := []struct{
+		Address ethaddr.Address
+		Diameter float64
+		ExpectedBackground string
+		ExpectedTransforms [3]string
+		ExpectedFills [3]string
+	}{
+		{
+			Address: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			Diameter: 32,
+			ExpectedBackground: "#1885F2",
+			ExpectedTransforms: [3]string{
+				"translate(7.674265201211072 5.703690760398247) rotate(142.3 16 16)",
+				"translate(-14.149484875132698 -3.9486130502119723) rotate(285.4 16 16)",
+				"translate(17.423544485378233 17.909109348415388) rotate(82.4 16 16)",
+			},
+			ExpectedFills: [3]string{"#FB1849", "#2353E1", "#F5DC00"},
+		},
+		{
+			Address: ethaddr.ParseStringElsePanic("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"),
+			Diameter: 40,
+			ExpectedBackground: "#FB182F",
+			ExpectedTransforms: [3]string{
+				"translate(-0.2829286409513919 3.3903850824245905) rotate(248.6 20 20)",
+				"translate(5.461229439412562 -19.96775099428366) rotate(320.7 20 20)",
+				"translate(-24.71677796344832 26.04512793494374) rotate(166.9 20 20)",
+			},
+			ExpectedFills: [3]string{"#FAAB00", "#F2D202", "#186BF2"},
+		},
+		{
+			Address: ethaddr.Zero(),
+			Diameter: 16,
+			ExpectedBackground: "#1897F2",
+			ExpectedTransforms: [3]string{
+				"translate(1.7946650923962586 -2.667155311616761) rotate(458.4 8 8)",
+				"translate(-7.681729644825104 3.9961758177433153) rotate(268.8 8 8)",
+				"translate(-4.538127039650711 14.740007940341687) rotate(117.3 8 8)",
+			},
+			ExpectedFills: [3]string{"#2362E1", "#F94301", "#FA7900"},
+		},
+		{
+			Address: ethaddr.ParseStringElsePanic("0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"),
+			Diameter: 25,
+			ExpectedBackground: "#F93F01",
+			ExpectedTransforms: [3]string{
+				"translate(-3.6101359058379145 -6.961129241706609) rotate(280.2 12.5 12.5)",
+				"translate(-7.528555916131661 -3.827204586698097) rotate(218.2 12.5 12.5)",
+				"translate(5.603994349283886 -22.386863606501496) rotate(317.6 12.5 12.5)",
+			},
+			ExpectedFills: [3]string{"#C8144D", "#F5C400", "#01898E"},
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		icon, err := jazzicon.Generate(test.Address, test.Diameter)
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("ADDRESS: %s", test.Address)
+			continue
+		}
+
+		{
+			expected := test.ExpectedBackground
+			actual := icon.Background
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual background is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %s", expected)
+				t.Logf("ACTUAL:   %s", actual)
+				t.Logf("ADDRESS: %s", test.Address)
+				continue
+			}
+		}
+
+		for shapeNumber, shape := range icon.Shapes {
+			{
+				expected := test.ExpectedTransforms[shapeNumber]
+				actual := shape.Transform(test.Diameter)
+
+				if expected != actual {
+					t.Errorf("For test #%d and shape #%d, the actual transform is not what was expected.", testNumber, shapeNumber)
+					t.Logf("EXPECTED: %s", expected)
+					t.Logf("ACTUAL:   %s", actual)
+					t.Logf("ADDRESS: %s", test.Address)
+					continue
+				}
+			}
+
+			{
+				expected := test.ExpectedFills[shapeNumber]
+				actual := shape.Fill
+
+				if expected != actual {
+					t.Errorf("For test #%d and shape #%d, the actual fill is not what was expected.", testNumber, shapeNumber)
+					t.Logf("EXPECTED: %s", expected)
+					t.Logf("ACTUAL:   %s", actual)
+					t.Logf("ADDRESS: %s", test.Address)
+					continue
+				}
+			}
+		}
+
+		{
+			var svg string = icon.SVG()
+
+			for _, expected := range test.ExpectedTransforms {
+				if !strings.Contains(svg, `transform="`+expected+`"`) {
+					t.Errorf("For test #%d, the actual SVG does not contain the expected transform.", testNumber)
+					t.Logf("EXPECTED: %s", expected)
+					t.Logf("SVG: %s", svg)
+					continue
+				}
+			}
+		}
+	}
+}
+
+func TestJazzicon_Image(t *testing.T) {
+
+	icon, err := jazzicon.Generate(ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"), 32)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	img, err := icon.Image(64)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	{
+		expected := 64
+		actual := img.Bounds().Dx()
+
+		if expected != actual {
+			t.Errorf("The actual width is not what was expected.")
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			return
+		}
+	}
+
+	// corners are outside of the circle.
+	if _, _, _, a := img.At(0, 0).RGBA(); 0 != a {
+		t.Errorf("Expected the corner to be transparent, but actually has alpha %d.", a)
+		return
+	}
+
+	// the center is inside of the circle.
+	if _, _, _, a := img.At(32, 32).RGBA(); 0xFFFF != a {
+		t.Errorf("Expected the center to be opaque, but actually has alpha %d.", a)
+		return
+	}
+}
+
+func TestJazzicon_Image_badWidth(t *testing.T) {
+
+	icon, err := jazzicon.Generate(ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"), 32)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	for _, width := range []int{0, -1} {
+		if _, err := icon.Image(width); nil == err {
+			t.Errorf("For width %d, expected an error but did not actually get one.", width)
+		}
+	}
+}
+
+func TestGenerate_nothing(t *testing.T) {
+
+	_, err := jazzicon.Generate(ethaddr.Nothing(), 32)
+	if nil == err {
+		t.Errorf("Expected an error but did not actually get one.")
+		return
+	}
+
+	{
+		expected := "jazzicon: eth-address is nothing"
+		actual := err.Error()
+
+		if expected != actual {
+			t.Errorf("The actual error is not what was expected.")
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			return
+		}
+	}
+}
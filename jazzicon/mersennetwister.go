@@ -0,0 +1,54 @@
+package jazzicon
+
+// mersenneTwister is the MT19937 pseudo-random number generator, as the (JavaScript) mersenne-twister library implements it.
+type mersenneTwister struct {
+	mt  [624]uint32
+	mti int
+}
+
+func newMersenneTwister(seed uint32) *mersenneTwister {
+	var generator mersenneTwister
+
+	generator.mt[0] = seed
+	for i:=1; i<len(generator.mt); i++ {
+		var s uint32 = generator.mt[i-1] ^ (generator.mt[i-1] >> 30)
+		generator.mt[i] = 1812433253*s + uint32(i)
+	}
+	generator.mti = len(generator.mt)
+
+	return &generator
+}
+
+func (receiver *mersenneTwister) uint32() uint32 {
+	const n = 624
+	const m = 397
+	const matrixA   uint32 = 0x9908b0df
+	const upperMask uint32 = 0x80000000
+	const lowerMask uint32 = 0x7fffffff
+
+	if n <= receiver.mti {
+		var mag01 = [2]uint32{0, matrixA}
+
+		for kk:=0; kk<n; kk++ {
+			var y uint32 = (receiver.mt[kk] & upperMask) | (receiver.mt[(kk+1)%n] & lowerMask)
+			receiver.mt[kk] = receiver.mt[(kk+m)%n] ^ (y >> 1) ^ mag01[y&0x1]
+		}
+
+		receiver.mti = 0
+	}
+
+	var y uint32 = receiver.mt[receiver.mti]
+	receiver.mti++
+
+	y ^= (y >> 11)
+	y ^= (y << 7) & 0x9d2c5680
+	y ^= (y << 15) & 0xefc60000
+	y ^= (y >> 18)
+
+	return y
+}
+
+// random returns a pseudo-random number in the range [0, 1).
+func (receiver *mersenneTwister) random() float64 {
+	return float64(receiver.uint32()) * (1.0 / 4294967296.0)
+}
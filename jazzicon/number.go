@@ -0,0 +1,64 @@
+package jazzicon
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// formatNumber formats the number the same way JavaScript's Number.prototype.toString does.
+func formatNumber(f float64) string {
+	if 0 == f {
+		return "0"
+	}
+
+	var abs float64 = math.Abs(f)
+	if abs < 1e-6 || 1e21 <= abs {
+		// JavaScript writes, for example, "1.5e-7" and "1e+21".
+		var s string = strconv.FormatFloat(f, 'e', -1, 64)
+		mantissa, exponent, _ := strings.Cut(s, "e")
+		var sign string = exponent[:1]
+		exponent = strings.TrimLeft(exponent[1:], "0")
+		return mantissa + "e" + sign + exponent
+	}
+
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// toFixed1 formats the number the same way JavaScript's Number.prototype.toFixed(1) does.
+//
+// (Unlike strconv, JavaScript rounds exact halves up, rather than to even.)
+func toFixed1(f float64) string {
+	var negative bool = f < 0
+	if negative {
+		f = -f
+	}
+
+	// A float64 of this size has an exact decimal expansion that fits in 60 fractional digits.
+	var exact string = strconv.FormatFloat(f, 'f', 60, 64)
+
+	whole, fraction, _ := strings.Cut(exact, ".")
+
+	var digits []byte = []byte(whole + fraction[:1])
+	if '5' <= fraction[1] {
+		// round up, carrying as needed.
+		i := len(digits) - 1
+		for ; 0 <= i; i-- {
+			if '9' != digits[i] {
+				digits[i]++
+				break
+			}
+			digits[i] = '0'
+		}
+		if i < 0 {
+			digits = append([]byte{'1'}, digits...)
+		}
+	}
+
+	var result string = string(digits[:len(digits)-1]) + "." + string(digits[len(digits)-1:])
+	if negative {
+		result = "-" + result
+	}
+
+	return result
+}
@@ -0,0 +1,68 @@
+package jazzicon
+
+import (
+	"testing"
+)
+
+func TestToFixed1(t *testing.T) {
+
+	tests := []struct{
+		Value float64
+		Expected string
+	}{
+		{Value: 0,       Expected: "0.0"},
+		{Value: 0.25,    Expected: "0.3"},
+		{Value: 0.35,    Expected: "0.3"}, // 0.35 is actually 0.34999999999999997779553950749686919152736663818359375
+		{Value: 1.05,    Expected: "1.1"}, // 1.05 is actually 1.0500000000000000444089209850062616169452667236328125
+		{Value: 9.95,    Expected: "9.9"}, // 9.95 is actually 9.949999999999999289457264239899814128875732421875
+		{Value: 9.96,    Expected: "10.0"},
+		{Value: 142.25,  Expected: "142.3"},
+		{Value: 539.999, Expected: "540.0"},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := toFixed1(test.Value)
+
+		expected := test.Expected
+
+		if expected != actual {
+			t.Errorf("For test #%d, the actual value is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			t.Logf("VALUE: %v", test.Value)
+			continue
+		}
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+
+	tests := []struct{
+		Value float64
+		Expected string
+	}{
+		{Value: 0,                   Expected: "0"},
+		{Value: 16,                  Expected: "16"},
+		{Value: 12.5,                Expected: "12.5"},
+		{Value: -3.9486130502119723, Expected: "-3.9486130502119723"},
+		{Value: 0.000001,            Expected: "0.000001"},
+		{Value: 0.00000015,          Expected: "1.5e-7"},
+		{Value: 1e21,                Expected: "1e+21"},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := formatNumber(test.Value)
+
+		expected := test.Expected
+
+		if expected != actual {
+			t.Errorf("For test #%d, the actual value is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			t.Logf("VALUE: %v", test.Value)
+			continue
+		}
+	}
+}
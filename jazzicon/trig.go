@@ -0,0 +1,211 @@
+package jazzicon
+
+import (
+	"math"
+)
+
+// The JavaScript engines compute Math.sin and Math.cos with (a port of) fdlibm, which can differ from Go's math.Sin and math.Cos in the last bit.
+// Since the jazzicon's SVG includes the full-precision results, sin and cos here are ports of fdlibm.
+//
+// (Only arguments with |x| <= 2^19 × π/2 are handled here — which is way more than jazzicon needs.
+// Anything larger falls back to Go's math.Sin and math.Cos.)
+
+func sin(x float64) float64 {
+	var ix uint32 = highWord(x) & 0x7fffffff
+
+	switch {
+	case ix <= 0x3fe921fb:
+		return kernelSin(x, 0, false)
+	case 0x7ff00000 <= ix:
+		return x - x
+	case 0x413921fb < ix:
+		return math.Sin(x)
+	}
+
+	n, y0, y1 := remPio2(x)
+	switch n & 3 {
+	case 0:
+		return kernelSin(y0, y1, true)
+	case 1:
+		return kernelCos(y0, y1)
+	case 2:
+		return -kernelSin(y0, y1, true)
+	default:
+		return -kernelCos(y0, y1)
+	}
+}
+
+func cos(x float64) float64 {
+	var ix uint32 = highWord(x) & 0x7fffffff
+
+	switch {
+	case ix <= 0x3fe921fb:
+		return kernelCos(x, 0)
+	case 0x7ff00000 <= ix:
+		return x - x
+	case 0x413921fb < ix:
+		return math.Cos(x)
+	}
+
+	n, y0, y1 := remPio2(x)
+	switch n & 3 {
+	case 0:
+		return kernelCos(y0, y1)
+	case 1:
+		return -kernelSin(y0, y1, true)
+	case 2:
+		return -kernelCos(y0, y1)
+	default:
+		return kernelSin(y0, y1, true)
+	}
+}
+
+func highWord(x float64) uint32 {
+	return uint32(math.Float64bits(x) >> 32)
+}
+
+// kernelSin is fdlibm's __kernel_sin — sin(x+y) for |x| ~< π/4.
+func kernelSin(x float64, y float64, hasY bool) float64 {
+	const (
+		S1 = -1.66666666666666324348e-01
+		S2 =  8.33333333332248946124e-03
+		S3 = -1.98412698298579493134e-04
+		S4 =  2.75573137070700676789e-06
+		S5 = -2.50507602534068634195e-08
+		S6 =  1.58969099521155010221e-10
+	)
+
+	var ix uint32 = highWord(x) & 0x7fffffff
+	if ix < 0x3e400000 && 0 == int(x) {
+		return x
+	}
+
+	var z float64 = x * x
+	var v float64 = z * x
+	var r float64 = S2 + z*(S3+z*(S4+z*(S5+z*S6)))
+
+	if !hasY {
+		return x + v*(S1+z*r)
+	}
+	return x - ((z*(0.5*y-v*r) - y) - v*S1)
+}
+
+// kernelCos is fdlibm's __kernel_cos — cos(x+y) for |x| ~< π/4.
+func kernelCos(x float64, y float64) float64 {
+	const (
+		C1 =  4.16666666666666019037e-02
+		C2 = -1.38888888888741095749e-03
+		C3 =  2.48015872894767294178e-05
+		C4 = -2.75573143513906633035e-07
+		C5 =  2.08757232129817482790e-09
+		C6 = -1.13596475577881948265e-11
+	)
+
+	var ix uint32 = highWord(x) & 0x7fffffff
+	if ix < 0x3e400000 && 0 == int(x) {
+		return 1
+	}
+
+	var z float64 = x * x
+	var r float64 = z * (C1 + z*(C2+z*(C3+z*(C4+z*(C5+z*C6)))))
+
+	if ix < 0x3fd33333 {
+		return 1 - (0.5*z - (z*r - x*y))
+	}
+
+	var qx float64
+	if ix > 0x3fe90000 {
+		qx = 0.28125
+	} else {
+		qx = math.Float64frombits(uint64(ix-0x00200000) << 32)
+	}
+
+	var hz float64 = 0.5*z - qx
+	var a float64 = 1 - qx
+
+	return a - (hz - (z*r - x*y))
+}
+
+// remPio2 is (the small and medium sized argument part of) fdlibm's __ieee754_rem_pio2 — it returns n, and x - n×π/2 (as y0+y1).
+func remPio2(x float64) (int, float64, float64) {
+	const (
+		invpio2 = 6.36619772367581382433e-01
+		pio2_1  = 1.57079632673412561417e+00
+		pio2_1t = 6.07710050650619224932e-11
+		pio2_2  = 6.07710050630396597660e-11
+		pio2_2t = 2.02226624879595063154e-21
+		pio2_3  = 2.02226624871116645580e-21
+		pio2_3t = 8.47842766036889956997e-32
+	)
+
+	var hx uint32 = highWord(x)
+	var ix uint32 = hx & 0x7fffffff
+	var negative bool = 0 != hx & 0x80000000
+
+	if ix <= 0x3fe921fb {
+		return 0, x, 0
+	}
+
+	if ix < 0x4002d97c {
+		var y0, y1 float64
+		if !negative {
+			var z float64 = x - pio2_1
+			if 0x3ff921fb != ix {
+				y0 = z - pio2_1t
+				y1 = (z - y0) - pio2_1t
+			} else {
+				z -= pio2_2
+				y0 = z - pio2_2t
+				y1 = (z - y0) - pio2_2t
+			}
+			return 1, y0, y1
+		} else {
+			var z float64 = x + pio2_1
+			if 0x3ff921fb != ix {
+				y0 = z + pio2_1t
+				y1 = (z - y0) + pio2_1t
+			} else {
+				z += pio2_2
+				y0 = z + pio2_2t
+				y1 = (z - y0) + pio2_2t
+			}
+			return -1, y0, y1
+		}
+	}
+
+	var t float64 = math.Abs(x)
+	var n int = int(t*invpio2 + 0.5)
+	var fn float64 = float64(n)
+	var r float64 = t - fn*pio2_1
+	var w float64 = fn * pio2_1t
+
+	var y0 float64
+	if n < 32 && ix != highWord(fn*math.Pi/2) {
+		y0 = r - w
+	} else {
+		var j int = int(ix >> 20)
+		y0 = r - w
+		var i int = j - int((highWord(y0) >> 20) & 0x7ff)
+		if 16 < i {
+			t = r
+			w = fn * pio2_2
+			r = t - w
+			w = fn*pio2_2t - ((t - r) - w)
+			y0 = r - w
+			i = j - int((highWord(y0) >> 20) & 0x7ff)
+			if 49 < i {
+				t = r
+				w = fn * pio2_3
+				r = t - w
+				w = fn*pio2_3t - ((t - r) - w)
+				y0 = r - w
+			}
+		}
+	}
+	var y1 float64 = (r - y0) - w
+
+	if negative {
+		return -n, -y0, -y1
+	}
+	return n, y0, y1
+}
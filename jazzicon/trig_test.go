@@ -0,0 +1,43 @@
+package jazzicon
+
+import (
+	"testing"
+)
+
+// The expected values were produced by JavaScript's Math.sin and Math.cos.
+func TestSinCos(t *testing.T) {
+
+	tests := []struct{
+		X float64
+		ExpectedSin float64
+		ExpectedCos float64
+	}{
+		{X: 0.5, ExpectedSin: 0.479425538604203, ExpectedCos: 0.8775825618903728},
+		{X: 1.234567, ExpectedSin: 0.9440054313672885, ExpectedCos: 0.3299299100552412},
+		{X: 2.5, ExpectedSin: 0.5984721441039564, ExpectedCos: -0.8011436155469337},
+		{X: 3.0000001, ExpectedSin: 0.14111990906061703, ExpectedCos: -0.9899925107124413},
+		{X: 4.71238898038469, ExpectedSin: -1, ExpectedCos: -1.8369701987210297e-16},
+		{X: 6.2, ExpectedSin: -0.0830894028174964, ExpectedCos: 0.9965420970232175},
+		{X: 100.5, ExpectedSin: -0.030959966783271346, ExpectedCos: 0.9995206253283515},
+		{X: -7.25, ExpectedSin: -0.8230808790115055, ExpectedCos: 0.5679241732886948},
+	}
+
+	for testNumber, test := range tests {
+
+		if expected, actual := test.ExpectedSin, sin(test.X); expected != actual {
+			t.Errorf("For test #%d, the actual sin is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %v", expected)
+			t.Logf("ACTUAL:   %v", actual)
+			t.Logf("X: %v", test.X)
+			continue
+		}
+
+		if expected, actual := test.ExpectedCos, cos(test.X); expected != actual {
+			t.Errorf("For test #%d, the actual cos is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %v", expected)
+			t.Logf("ACTUAL:   %v", actual)
+			t.Logf("X: %v", test.X)
+			continue
+		}
+	}
+}
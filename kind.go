@@ -0,0 +1,68 @@
+package ethaddr
+
+// Kind classifies an eth-address.
+//
+// See the Kind method on Address.
+type Kind int
+
+const (
+	KindNothing Kind = iota
+	KindRegular
+	KindZero
+	KindPrecompile
+	KindNativeTokenSentinel
+	KindBurn
+	KindSystem
+)
+
+// String returns the name of the kind.
+//
+// For example:
+//
+//	ethaddr.KindPrecompile.String() == "precompile"
+func (receiver Kind) String() string {
+	switch receiver {
+	case KindNothing:
+		return "nothing"
+	case KindRegular:
+		return "regular"
+	case KindZero:
+		return "zero"
+	case KindPrecompile:
+		return "precompile"
+	case KindNativeTokenSentinel:
+		return "native-token-sentinel"
+	case KindBurn:
+		return "burn"
+	case KindSystem:
+		return "system"
+	default:
+		return "unknown"
+	}
+}
+
+// isPrecompile returns true if 'value' is the eth-address of a known precompile.
+//
+// These are:
+//
+//	0x01 through 0x0a (Frontier through Cancun)
+//	0x0b through 0x11 (EIP-2537 BLS12-381 precompiles, from Prague)
+//	0x0100            (RIP-7212 P-256 verification, on some L2 networks)
+func isPrecompile(value [AddressLength]byte) bool {
+	for _, b := range value[:AddressLength-2] {
+		if 0x00 != b {
+			return false
+		}
+	}
+
+	var n uint16 = uint16(value[AddressLength-2])<<8 | uint16(value[AddressLength-1])
+
+	switch {
+	case 0x01 <= n && n <= 0x11:
+		return true
+	case P256VerifyPrecompile == n:
+		return true
+	default:
+		return false
+	}
+}
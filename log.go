@@ -0,0 +1,155 @@
+package ethaddr
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"sync/atomic"
+)
+
+// LogMode is how an eth-address is written to a log.
+//
+// See LogRedaction.
+type LogMode int
+
+const (
+	LogModeFull  LogMode = iota // 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed
+	LogModeShort                // 0x5aAe…BeAed
+	LogModeHash                 // hmac:6f1c0b2d8e4a7f93 (a keyed hash, so that the same eth-address always logs the same way)
+	LogModeOmit                 // (the attribute is left out)
+)
+
+// LogRedaction configures how an eth-address is written to a log.
+//
+// It can be used for all logs (see SetLogRedaction), or for a single slog.Handler (see the ReplaceAttr method).
+//
+// Key is the key for LogModeHash.
+// (If Key is empty, then the hash is NOT private — anyone can compute it for an eth-address they are looking for.)
+type LogRedaction struct {
+	Mode LogMode
+	Key  []byte
+}
+
+var logRedaction atomic.Pointer[LogRedaction]
+
+// SetLogRedaction sets how eth-addresses are written to all logs (by the LogValue method).
+//
+// The default is LogModeFull.
+//
+// For example:
+//
+//	ethaddr.SetLogRedaction(ethaddr.LogRedaction{Mode: ethaddr.LogModeShort})
+func SetLogRedaction(redaction LogRedaction) {
+	redaction.Key = append([]byte(nil), redaction.Key...)
+	logRedaction.Store(&redaction)
+}
+
+func currentLogRedaction() LogRedaction {
+	var redaction *LogRedaction = logRedaction.Load()
+	if nil == redaction {
+		return LogRedaction{}
+	}
+
+	return *redaction
+}
+
+// Redact returns how 'address' is written to a log.
+//
+// For LogModeOmit (and for Nothing()), Redact returns an empty string.
+func (receiver LogRedaction) Redact(address Address) string {
+	value, something := address.Get()
+	if !something {
+		return ""
+	}
+
+	switch receiver.Mode {
+	case LogModeShort:
+		var eip55 string = address.EIP55()
+		return eip55[:6] + "…" + eip55[len(eip55)-5:]
+	case LogModeHash:
+		var mac = hmac.New(sha256.New, receiver.Key)
+		mac.Write(value[:])
+		return "hmac:" + hex.EncodeToString(mac.Sum(nil)[:8])
+	case LogModeOmit:
+		return ""
+	default:
+		return address.EIP55()
+	}
+}
+
+// value returns the slog.Value for 'address'.
+func (receiver LogRedaction) value(address Address) slog.Value {
+	if address.IsNothing() {
+		return slog.AnyValue(nil)
+	}
+	if LogModeOmit == receiver.Mode {
+		// Handlers leave out attributes whose value is an empty group.
+		return slog.GroupValue()
+	}
+
+	return slog.StringValue(receiver.Redact(address))
+}
+
+// ReplaceAttr redacts eth-addresses, and can be used as the ReplaceAttr of a slog.HandlerOptions.
+//
+// For example:
+//
+//	var redaction = ethaddr.LogRedaction{Mode: ethaddr.LogModeHash, Key: key}
+//	
+//	var logger = slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+//		ReplaceAttr: redaction.ReplaceAttr,
+//	}))
+//
+// ReplaceAttr applies to this handler instead of SetLogRedaction.
+// (Except that if SetLogRedaction is set to LogModeOmit, then there is nothing left for ReplaceAttr to redact.)
+func (receiver LogRedaction) ReplaceAttr(groups []string, attr slog.Attr) slog.Attr {
+	if slog.KindAny != attr.Value.Kind() {
+		return attr
+	}
+
+	logged, casted := attr.Value.Any().(loggedAddress)
+	if !casted {
+		return attr
+	}
+
+	if LogModeOmit == receiver.Mode {
+		return slog.Attr{}
+	}
+
+	return slog.Attr{Key: attr.Key, Value: receiver.value(logged.address)}
+}
+
+// loggedAddress is what the LogValue method (usually) returns, so that ReplaceAttr can find eth-addresses (after the handler has resolved them).
+type loggedAddress struct {
+	address Address
+}
+
+// MarshalText is used by slog.TextHandler and slog.JSONHandler (when ReplaceAttr is not used).
+func (receiver loggedAddress) MarshalText() ([]byte, error) {
+	return []byte(currentLogRedaction().Redact(receiver.address)), nil
+}
+
+// String is used by other handlers.
+func (receiver loggedAddress) String() string {
+	return currentLogRedaction().Redact(receiver.address)
+}
+
+var _ slog.LogValuer = Address{}
+
+// LogValue makes it so Address implements slog.LogValuer.
+//
+// Something() is written as set by SetLogRedaction (which defaults to the EIP-55 / ERC-55 encoding).
+// (Or as set by a handler's ReplaceAttr — see the ReplaceAttr method of LogRedaction.)
+//
+// Nothing() is written as null (rather than as an empty string).
+func (receiver Address) LogValue() slog.Value {
+	if receiver.IsNothing() {
+		return slog.AnyValue(nil)
+	}
+	if LogModeOmit == currentLogRedaction().Mode {
+		return slog.GroupValue()
+	}
+
+	return slog.AnyValue(loggedAddress{address: receiver})
+}
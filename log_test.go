@@ -0,0 +1,150 @@
+package ethaddr_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestAddress_LogValue(t *testing.T) {
+
+	var address ethaddr.Address = ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	tests := []struct{
+		Redaction ethaddr.LogRedaction
+		Address ethaddr.Address
+		Expected string
+	}{
+		{
+			Redaction: ethaddr.LogRedaction{},
+			Address: address,
+			Expected: `{"msg":"m","to":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}`+"\n",
+		},
+		{
+			Redaction: ethaddr.LogRedaction{},
+			Address: ethaddr.Nothing(),
+			Expected: `{"msg":"m","to":null}`+"\n",
+		},
+		{
+			Redaction: ethaddr.LogRedaction{Mode: ethaddr.LogModeShort},
+			Address: address,
+			Expected: `{"msg":"m","to":"0x5aAe…BeAed"}`+"\n",
+		},
+		{
+			Redaction: ethaddr.LogRedaction{Mode: ethaddr.LogModeShort},
+			Address: ethaddr.Nothing(),
+			Expected: `{"msg":"m","to":null}`+"\n",
+		},
+		{
+			Redaction: ethaddr.LogRedaction{Mode: ethaddr.LogModeHash, Key: []byte("secret")},
+			Address: address,
+			// "dd7ff415316d6d26" is the first 8 bytes of HMAC-SHA256, with the key "secret", of the 20 bytes of the eth-address.
+			Expected: `{"msg":"m","to":"hmac:dd7ff415316d6d26"}`+"\n",
+		},
+		{
+			Redaction: ethaddr.LogRedaction{Mode: ethaddr.LogModeOmit},
+			Address: address,
+			Expected: `{"msg":"m"}`+"\n",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		ethaddr.SetLogRedaction(test.Redaction)
+
+		var buffer bytes.Buffer
+		var logger *slog.Logger = slog.New(slog.NewJSONHandler(&buffer, &slog.HandlerOptions{ReplaceAttr: removeTimeAndLevel}))
+
+		logger.Info("m", "to", test.Address)
+
+		if expected, actual := test.Expected, buffer.String(); expected != actual {
+			t.Errorf("For test #%d, the actual log is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+	}
+
+	ethaddr.SetLogRedaction(ethaddr.LogRedaction{})
+}
+
+func TestLogRedaction_ReplaceAttr(t *testing.T) {
+
+	var address ethaddr.Address = ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	tests := []struct{
+		Redaction ethaddr.LogRedaction
+		Expected string
+	}{
+		{
+			Redaction: ethaddr.LogRedaction{},
+			Expected: `msg=m to=0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed none=<nil> n=5 g.from=0x000000000000000000000000000000000000dEaD`+"\n",
+		},
+		{
+			Redaction: ethaddr.LogRedaction{Mode: ethaddr.LogModeShort},
+			Expected: `msg=m to=0x5aAe…BeAed none=<nil> n=5 g.from=0x0000…0dEaD`+"\n",
+		},
+		{
+			Redaction: ethaddr.LogRedaction{Mode: ethaddr.LogModeOmit},
+			Expected: `msg=m none=<nil> n=5`+"\n",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var buffer bytes.Buffer
+		var logger *slog.Logger = slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{
+			ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+				return test.Redaction.ReplaceAttr(groups, removeTimeAndLevel(groups, attr))
+			},
+		}))
+
+		logger.Info("m", "to", address, "none", ethaddr.Nothing(), "n", 5, slog.Group("g", "from", ethaddr.Dead()))
+
+		if expected, actual := test.Expected, buffer.String(); expected != actual {
+			t.Errorf("For test #%d, the actual log is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+	}
+}
+
+func TestLogRedaction_Redact(t *testing.T) {
+
+	var address ethaddr.Address = ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	var a string = ethaddr.LogRedaction{Mode: ethaddr.LogModeHash, Key: []byte("key-1")}.Redact(address)
+	var b string = ethaddr.LogRedaction{Mode: ethaddr.LogModeHash, Key: []byte("key-1")}.Redact(address)
+	var c string = ethaddr.LogRedaction{Mode: ethaddr.LogModeHash, Key: []byte("key-2")}.Redact(address)
+
+	if expected, actual := "hmac:6b0b4ea3729f3973", a; expected != actual {
+		t.Errorf("The actual hash is not what was expected.")
+		t.Logf("EXPECTED: %s", expected)
+		t.Logf("ACTUAL:   %s", actual)
+	}
+	if a != b {
+		t.Errorf("Expected the same key to give the same hash, but it did not.")
+		t.Logf("A: %s", a)
+		t.Logf("B: %s", b)
+	}
+	if a == c {
+		t.Errorf("Expected different keys to give different hashes, but they did not.")
+		t.Logf("A: %s", a)
+		t.Logf("C: %s", c)
+	}
+	if expected, actual := len("hmac:")+16, len(a); expected != actual {
+		t.Errorf("The actual length of the hash is not what was expected.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+	}
+}
+
+func removeTimeAndLevel(groups []string, attr slog.Attr) slog.Attr {
+	if 0 == len(groups) && (slog.TimeKey == attr.Key || slog.LevelKey == attr.Key) {
+		return slog.Attr{}
+	}
+	return attr
+}
@@ -0,0 +1,140 @@
+package poison
+
+import (
+	"sort"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+// DefaultThreshold is the threshold a Detector uses if its Threshold is zero.
+//
+// With the default threshold, a candidate is flagged if it shares at least 6 hexadecimal-symbols (combined) at its ends with a known eth-address,
+// or if it differs from a known eth-address in at most 10 hexadecimal-symbols.
+const DefaultThreshold = 0.75
+
+// Match is a known eth-address that a candidate looks like.
+type Match struct {
+	Known ethaddr.Address
+	Similarity
+}
+
+// Detector flags candidate eth-addresses that look like (but are not) known-good eth-addresses.
+//
+// For example:
+//
+//	var detector poison.Detector
+//	detector.Add(counterparty1, counterparty2, counterparty3)
+//	
+//	if matches := detector.Check(candidate); 0 < len(matches) {
+//		// candidate might be an address-poisoning attempt.
+//	}
+//
+// The zero value is usable.
+// Add and Check are not safe to call concurrently.
+type Detector struct {
+	Threshold float64
+
+	known []knownAddress
+	index map[[ethaddr.AddressLength]byte]struct{}
+}
+
+type knownAddress struct {
+	address ethaddr.Address
+	hex     [hexLength]byte
+}
+
+func (receiver *Detector) threshold() float64 {
+	if receiver.Threshold <= 0 {
+		return DefaultThreshold
+	}
+	return receiver.Threshold
+}
+
+// Add adds known-good eth-addresses to the detector.
+//
+// Nothing eth-addresses, and eth-addresses that were already added, are ignored.
+func (receiver *Detector) Add(addresses ...ethaddr.Address) {
+	if nil == receiver {
+		return
+	}
+
+	if nil == receiver.index {
+		receiver.index = map[[ethaddr.AddressLength]byte]struct{}{}
+	}
+
+	for _, address := range addresses {
+		value, something := address.Get()
+		if !something {
+			continue
+		}
+		if _, found := receiver.index[value]; found {
+			continue
+		}
+
+		receiver.index[value] = struct{}{}
+		receiver.known = append(receiver.known, knownAddress{
+			address: address,
+			hex: hexify(value),
+		})
+	}
+}
+
+// IsKnown returns true if 'candidate' is one of the known-good eth-addresses.
+func (receiver *Detector) IsKnown(candidate ethaddr.Address) bool {
+	if nil == receiver {
+		return false
+	}
+
+	value, something := candidate.Get()
+	if !something {
+		return false
+	}
+
+	_, found := receiver.index[value]
+	return found
+}
+
+// Check returns the known-good eth-addresses that 'candidate' looks like, whose score is at or above the threshold.
+// The matches are ordered from highest score to lowest.
+//
+// If 'candidate' is itself a known-good eth-address, then Check returns nil.
+func (receiver *Detector) Check(candidate ethaddr.Address) []Match {
+	if nil == receiver {
+		return nil
+	}
+
+	value, something := candidate.Get()
+	if !something {
+		return nil
+	}
+	if receiver.IsKnown(candidate) {
+		return nil
+	}
+
+	var hex [hexLength]byte = hexify(value)
+	var threshold float64 = receiver.threshold()
+
+	var matches []Match
+	for _, known := range receiver.known {
+		similarity := compare(known.hex, hex)
+		if similarity.Score < threshold {
+			continue
+		}
+
+		matches = append(matches, Match{
+			Known: known.address,
+			Similarity: similarity,
+		})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	return matches
+}
+
+// IsLookalike returns true if 'candidate' looks like (but is not) a known-good eth-address.
+func (receiver *Detector) IsLookalike(candidate ethaddr.Address) bool {
+	return 0 < len(receiver.Check(candidate))
+}
@@ -0,0 +1,93 @@
+package poison_test
+
+import (
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+	"github.com/reiver/go-ethaddr/poison"
+)
+
+func TestDetector_Check(t *testing.T) {
+
+	var detector poison.Detector
+	detector.Add(
+		ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+		ethaddr.ParseStringElsePanic("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"),
+		ethaddr.ParseStringElsePanic("0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"),
+	)
+
+	tests := []struct{
+		Candidate ethaddr.Address
+		Expected []string
+	}{
+		{
+			// known
+			Candidate: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			Expected: nil,
+		},
+		{
+			// unrelated
+			Candidate: ethaddr.ParseStringElsePanic("0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb"),
+			Expected: nil,
+		},
+		{
+			// poisoned: same first 4 and last 4
+			Candidate: ethaddr.ParseStringElsePanic("0x5aae1234567890123456789012345678901beaed"),
+			Expected: []string{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		},
+		{
+			// poisoned: same first 3 and last 3
+			Candidate: ethaddr.ParseStringElsePanic("0xfb61234567890123456789012345678901234359"),
+			Expected: []string{"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"},
+		},
+		{
+			// not enough: same first 2 and last 2
+			Candidate: ethaddr.ParseStringElsePanic("0xdb12345678901234567890123456789012345dfb"),
+			Expected: nil,
+		},
+		{
+			// typo
+			Candidate: ethaddr.ParseStringElsePanic("0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FC"),
+			Expected: []string{"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"},
+		},
+		{
+			Candidate: ethaddr.Nothing(),
+			Expected: nil,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		matches := detector.Check(test.Candidate)
+
+		if expected, actual := len(test.Expected), len(matches); expected != actual {
+			t.Errorf("For test #%d, the actual number of matches is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			t.Logf("CANDIDATE: %s", test.Candidate)
+			t.Logf("MATCHES: %#v", matches)
+			continue
+		}
+
+		for matchNumber, match := range matches {
+			expected := test.Expected[matchNumber]
+			actual := match.Known.String()
+
+			if expected != actual {
+				t.Errorf("For test #%d and match #%d, the actual known eth-address is not what was expected.", testNumber, matchNumber)
+				t.Logf("EXPECTED: %s", expected)
+				t.Logf("ACTUAL:   %s", actual)
+				t.Logf("CANDIDATE: %s", test.Candidate)
+				continue
+			}
+		}
+
+		if expected, actual := 0 < len(test.Expected), detector.IsLookalike(test.Candidate); expected != actual {
+			t.Errorf("For test #%d, the actual is-lookalike is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %t", expected)
+			t.Logf("ACTUAL:   %t", actual)
+			t.Logf("CANDIDATE: %s", test.Candidate)
+			continue
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package poison
+
+import (
+	"github.com/reiver/go-ethaddr"
+)
+
+const hexLength = ethaddr.AddressLength * 2
+
+// affixSaturation is the combined prefix-and-suffix length at which the affix-score reaches 1.
+//
+// Address-poisoning attackers typically grind for 4 to 6 matching hexadecimal-symbols at each end.
+const affixSaturation = 8
+
+// Similarity describes how similar two eth-addresses look, on their (case-insensitive) hexadecimal form.
+//
+// PrefixLength is the number of leading hexadecimal-symbols (after the "0x") that are the same.
+//
+// SuffixLength is the number of trailing hexadecimal-symbols that are the same.
+//
+// HammingDistance is the number of hexadecimal-symbols (by position) that are different.
+//
+// Score is between 0 and 1, where 1 means the two eth-addresses look alike.
+// It is the greater of:
+//
+//	(PrefixLength + SuffixLength) / 8   (capped at 1)
+//
+// And:
+//
+//	1 - HammingDistance / 40
+//
+// I.e., a candidate scores high if either its ends match (what address-poisoning relies on)
+// or if it differs in only a few places (what a typo looks like).
+type Similarity struct {
+	PrefixLength    int
+	SuffixLength    int
+	HammingDistance int
+	Score           float64
+}
+
+// Compare returns how similar 'a' and 'b' look.
+//
+// If either 'a' or 'b' is nothing, then Compare returns the zero value.
+func Compare(a ethaddr.Address, b ethaddr.Address) Similarity {
+	aValue, something := a.Get()
+	if !something {
+		return Similarity{}
+	}
+	bValue, something := b.Get()
+	if !something {
+		return Similarity{}
+	}
+
+	return compare(hexify(aValue), hexify(bValue))
+}
+
+func compare(a [hexLength]byte, b [hexLength]byte) Similarity {
+	var similarity Similarity
+
+	for similarity.PrefixLength < hexLength && a[similarity.PrefixLength] == b[similarity.PrefixLength] {
+		similarity.PrefixLength++
+	}
+
+	for similarity.SuffixLength < hexLength && a[hexLength-1-similarity.SuffixLength] == b[hexLength-1-similarity.SuffixLength] {
+		similarity.SuffixLength++
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			similarity.HammingDistance++
+		}
+	}
+
+	{
+		var affixScore float64 = float64(similarity.PrefixLength + similarity.SuffixLength) / affixSaturation
+		if 1 < affixScore {
+			affixScore = 1
+		}
+
+		var hammingScore float64 = 1 - float64(similarity.HammingDistance) / hexLength
+
+		similarity.Score = max(affixScore, hammingScore)
+	}
+
+	return similarity
+}
+
+// hexify returns the lower-case hexadecimal form of the eth-address (without the "0x" prefix).
+func hexify(value [ethaddr.AddressLength]byte) [hexLength]byte {
+	const symbols string = "0123456789abcdef"
+
+	var hex [hexLength]byte
+	for i, b := range value {
+		hex[i*2]   = symbols[b>>4]
+		hex[i*2+1] = symbols[b&0x0F]
+	}
+
+	return hex
+}
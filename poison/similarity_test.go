@@ -0,0 +1,82 @@
+package poison_test
+
+import (
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+	"github.com/reiver/go-ethaddr/poison"
+)
+
+func TestCompare(t *testing.T) {
+
+	tests := []struct{
+		A ethaddr.Address
+		B ethaddr.Address
+		Expected poison.Similarity
+	}{
+		{
+			A: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			B: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			Expected: poison.Similarity{PrefixLength:40, SuffixLength:40, HammingDistance:0, Score:1},
+		},
+		{
+			A: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			B: ethaddr.ParseStringElsePanic("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"),
+			Expected: poison.Similarity{PrefixLength:40, SuffixLength:40, HammingDistance:0, Score:1},
+		},
+		{
+			A: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			B: ethaddr.ParseStringElsePanic("0x5aAe000000000000000000000000000000000Aed"),
+			Expected: poison.Similarity{PrefixLength:4, SuffixLength:3, HammingDistance:31, Score:0.875},
+		},
+		{
+			A: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			B: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAee"),
+			Expected: poison.Similarity{PrefixLength:39, SuffixLength:0, HammingDistance:1, Score:1},
+		},
+		{
+			A: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			B: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1Bea00"),
+			Expected: poison.Similarity{PrefixLength:38, SuffixLength:0, HammingDistance:2, Score:1},
+		},
+		{
+			A: ethaddr.ParseStringElsePanic("0x0000000000000000000000000000000000000000"),
+			B: ethaddr.ParseStringElsePanic("0x0000000000000000000011111111111111111111"),
+			Expected: poison.Similarity{PrefixLength:20, SuffixLength:0, HammingDistance:20, Score:1},
+		},
+		{
+			A: ethaddr.ParseStringElsePanic("0x0000000000000000000000000000000000000000"),
+			B: ethaddr.ParseStringElsePanic("0x1111111111111111111111111111111111111111"),
+			Expected: poison.Similarity{PrefixLength:0, SuffixLength:0, HammingDistance:40, Score:0},
+		},
+		{
+			A: ethaddr.ParseStringElsePanic("0x0000000000000000000000000000000000000000"),
+			B: ethaddr.ParseStringElsePanic("0x0111111111111111111111111111111111111110"),
+			Expected: poison.Similarity{PrefixLength:1, SuffixLength:1, HammingDistance:38, Score:0.25},
+		},
+
+
+
+		{
+			A: ethaddr.Nothing(),
+			B: ethaddr.ParseStringElsePanic("0x0000000000000000000000000000000000000000"),
+			Expected: poison.Similarity{},
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := poison.Compare(test.A, test.B)
+
+		expected := test.Expected
+
+		if expected != actual {
+			t.Errorf("For test #%d, the actual similarity is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %#v", expected)
+			t.Logf("ACTUAL:   %#v", actual)
+			t.Logf("A: %s", test.A)
+			t.Logf("B: %s", test.B)
+			continue
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package pseudo
+
+import (
+	"github.com/reiver/go-erorr"
+)
+
+const (
+	errBadKeyID          = erorr.Error("pseudo: key ID must not be empty, and must not contain a colon")
+	errKeyTooShort       = erorr.Error("pseudo: key secret must be at least 16 bytes")
+	errNilPseudonymizer  = erorr.Error("pseudo: nil pseudonymizer")
+	errNoKeys            = erorr.Error("pseudo: no keys")
+)
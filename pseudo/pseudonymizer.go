@@ -0,0 +1,215 @@
+package pseudo
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/reiver/go-erorr"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+// MinKeyLength is the minimum length (in bytes) of the Secret of a Key.
+const MinKeyLength = 16
+
+// These are used for domain separation, so that a token and a pseudonym eth-address (for the same eth-address and key) are unrelated.
+const (
+	domainToken   = "ethaddr/pseudo/token\x00"
+	domainAddress = "ethaddr/pseudo/address\x00"
+)
+
+// Key is a (secret) key for a Pseudonymizer.
+//
+// ID is included in each token, so that it is known which key made it.
+// ID must not be empty, and must not contain a colon.
+//
+// ID is NOT secret. Secret is.
+type Key struct {
+	ID     string
+	Secret []byte
+}
+
+func (receiver Key) validate() error {
+	if "" == receiver.ID || strings.Contains(receiver.ID, ":") {
+		return errBadKeyID
+	}
+	if len(receiver.Secret) < MinKeyLength {
+		return errKeyTooShort
+	}
+
+	return nil
+}
+
+func (receiver Key) mac(domain string, address [ethaddr.AddressLength]byte) []byte {
+	var mac = hmac.New(sha256.New, receiver.Secret)
+	mac.Write([]byte(domain))
+	mac.Write(address[:])
+	return mac.Sum(nil)
+}
+
+// token returns the token for 'address' with this key.
+func (receiver Key) token(address [ethaddr.AddressLength]byte) string {
+	return receiver.ID + ":" + hex.EncodeToString(receiver.mac(domainToken, address)[:16])
+}
+
+// Pseudonymizer deterministically maps eth-addresses to pseudonyms, using a (secret) key.
+//
+// The same eth-address (with the same key) always maps to the same pseudonym, so that joins still work.
+// But, without the key, the pseudonym does not reveal the eth-address.
+//
+// There are two kinds of pseudonym:
+//
+//	Token    "2024-q1:3b6f0a2e9d4c81f7a5e2b0c9d8f7e6a1" — the key ID, a colon, and 128 bits of HMAC-SHA256
+//	Address  a well-formed eth-address — 160 bits of HMAC-SHA256
+//
+// The first key is the current key, which is used for new pseudonyms.
+// Any other keys are previous keys, which are kept (after a rotation) so that old tokens can still be matched.
+//
+// Use New to make a Pseudonymizer. (The zero value is not usable.)
+//
+// For example:
+//
+//	pseudonymizer, err := pseudo.New(pseudo.Key{ID: "2024-q1", Secret: secret})
+//	
+//	token := pseudonymizer.Token(address)
+type Pseudonymizer struct {
+	keys []Key
+}
+
+// New returns a new Pseudonymizer with 'current' as the current key, and 'previous' as previous keys.
+func New(current Key, previous ...Key) (*Pseudonymizer, error) {
+	var keys []Key = append([]Key{current}, previous...)
+
+	var ids = map[string]struct{}{}
+	for index, key := range keys {
+		if err := key.validate(); nil != err {
+			return nil, erorr.Errorf("pseudo: key #%d (%q): %w", index+1, key.ID, err)
+		}
+		if _, found := ids[key.ID]; found {
+			return nil, erorr.Errorf("pseudo: duplicate key ID %q", key.ID)
+		}
+		ids[key.ID] = struct{}{}
+
+		keys[index].Secret = append([]byte(nil), key.Secret...)
+	}
+
+	return &Pseudonymizer{keys: keys}, nil
+}
+
+// Rotate returns a new Pseudonymizer with 'next' as its current key, and all of the keys of the receiver as previous keys.
+//
+// The receiver is not changed.
+func (receiver *Pseudonymizer) Rotate(next Key) (*Pseudonymizer, error) {
+	if nil == receiver {
+		return nil, errNilPseudonymizer
+	}
+
+	return New(next, receiver.keys...)
+}
+
+// KeyIDs returns the IDs of the keys — the current key first.
+func (receiver *Pseudonymizer) KeyIDs() []string {
+	if nil == receiver {
+		return nil
+	}
+
+	var ids []string
+	for _, key := range receiver.keys {
+		ids = append(ids, key.ID)
+	}
+
+	return ids
+}
+
+// Token returns the token for 'address', using the current key.
+//
+// If 'address' is Nothing(), then Token returns an empty string.
+//
+// Token panics if the pseudonymizer has no keys — such as a nil or zero-value Pseudonymizer, rather than one from New.
+func (receiver *Pseudonymizer) Token(address ethaddr.Address) string {
+	if nil == receiver || len(receiver.keys) < 1 {
+		panic(errNoKeys)
+	}
+
+	value, something := address.Get()
+	if !something {
+		return ""
+	}
+
+	return receiver.keys[0].token(value)
+}
+
+// Tokens returns the token for 'address' for each key — the current key first.
+//
+// This can be used (by whoever has the eth-addresses) to make a table that maps old tokens to new tokens after a rotation.
+//
+// If 'address' is Nothing(), then Tokens returns nil.
+func (receiver *Pseudonymizer) Tokens(address ethaddr.Address) []string {
+	if nil == receiver {
+		return nil
+	}
+
+	value, something := address.Get()
+	if !something {
+		return nil
+	}
+
+	var tokens []string
+	for _, key := range receiver.keys {
+		tokens = append(tokens, key.token(value))
+	}
+
+	return tokens
+}
+
+// Matches returns true if 'token' is the token for 'address' using any of the keys (current or previous).
+func (receiver *Pseudonymizer) Matches(token string, address ethaddr.Address) bool {
+	if nil == receiver {
+		return false
+	}
+
+	value, something := address.Get()
+	if !something {
+		return false
+	}
+
+	id, _, found := strings.Cut(token, ":")
+	if !found {
+		return false
+	}
+
+	for _, key := range receiver.keys {
+		if id != key.ID {
+			continue
+		}
+		return hmac.Equal([]byte(token), []byte(key.token(value)))
+	}
+
+	return false
+}
+
+// Address returns a pseudonym for 'address' that is itself a well-formed eth-address, using the current key.
+//
+// This is useful when the pseudonymized data must still look like (and validate as) eth-addresses.
+// Note that, unlike a token, it does not say which key made it.
+//
+// If 'address' is Nothing(), then Address returns Nothing().
+//
+// Address panics if the pseudonymizer has no keys — such as a nil or zero-value Pseudonymizer, rather than one from New.
+func (receiver *Pseudonymizer) Address(address ethaddr.Address) ethaddr.Address {
+	if nil == receiver || len(receiver.keys) < 1 {
+		panic(errNoKeys)
+	}
+
+	value, something := address.Get()
+	if !something {
+		return ethaddr.Nothing()
+	}
+
+	var pseudonym [ethaddr.AddressLength]byte
+	copy(pseudonym[:], receiver.keys[0].mac(domainAddress, value))
+
+	return ethaddr.Something(pseudonym)
+}
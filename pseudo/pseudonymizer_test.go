@@ -0,0 +1,155 @@
+package pseudo_test
+
+import (
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+	"github.com/reiver/go-ethaddr/pseudo"
+)
+
+var testKey1 = pseudo.Key{ID: "k1", Secret: []byte("0123456789abcdef")}
+var testKey2 = pseudo.Key{ID: "k2", Secret: []byte("fedcba9876543210")}
+
+func TestPseudonymizer_Token(t *testing.T) {
+
+	pseudonymizer, err := pseudo.New(testKey1)
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: %s", err)
+	}
+
+	tests := []struct{
+		Address ethaddr.Address
+		Expected string
+	}{
+		{
+			Address: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			Expected: "k1:6a62fab72e26ad2f716c854213ddf737",
+		},
+		{
+			Address: ethaddr.Dead(),
+			Expected: "k1:8662f4d1adaba83ea102db7a30f065db",
+		},
+		{
+			Address: ethaddr.Nothing(),
+			Expected: "",
+		},
+	}
+
+	for testNumber, test := range tests {
+		if expected, actual := test.Expected, pseudonymizer.Token(test.Address); expected != actual {
+			t.Errorf("For test #%d, the actual token is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %q", expected)
+			t.Logf("ACTUAL:   %q", actual)
+			t.Logf("ADDRESS: %#v", test.Address)
+			continue
+		}
+	}
+}
+
+func TestPseudonymizer_Address(t *testing.T) {
+
+	pseudonymizer, err := pseudo.New(testKey1)
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: %s", err)
+	}
+
+	var expected ethaddr.Address = ethaddr.ParseStringElsePanic("0xc546650bd19d7ea1e5a667fc28d610ca38c21781")
+	var actual ethaddr.Address = pseudonymizer.Address(ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+
+	if expected != actual {
+		t.Errorf("The actual pseudonym eth-address is not what was expected.")
+		t.Logf("EXPECTED: %#v", expected)
+		t.Logf("ACTUAL:   %#v", actual)
+	}
+
+	if !pseudonymizer.Address(ethaddr.Nothing()).IsNothing() {
+		t.Errorf("Expected nothing to map to nothing.")
+	}
+}
+
+func TestPseudonymizer_Rotate(t *testing.T) {
+
+	var address ethaddr.Address = ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	old, err := pseudo.New(testKey1)
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: %s", err)
+	}
+
+	rotated, err := old.Rotate(testKey2)
+	if nil != err {
+		t.Fatalf("Did not expect an error when rotating but actually got one: %s", err)
+	}
+
+	if expected, actual := "k2:k1", rotated.KeyIDs()[0]+":"+rotated.KeyIDs()[1]; expected != actual {
+		t.Errorf("The actual key IDs are not what was expected.")
+		t.Logf("EXPECTED: %s", expected)
+		t.Logf("ACTUAL:   %s", actual)
+	}
+
+	var oldToken string = old.Token(address)
+	var newToken string = rotated.Token(address)
+
+	if oldToken == newToken {
+		t.Errorf("Expected the token to change after rotation, but it did not.")
+	}
+
+	if tokens := rotated.Tokens(address); 2 != len(tokens) || newToken != tokens[0] || oldToken != tokens[1] {
+		t.Errorf("The actual tokens are not what was expected.")
+		t.Logf("TOKENS: %#v", tokens)
+	}
+
+	if !rotated.Matches(oldToken, address) {
+		t.Errorf("Expected the old token to match after rotation, but it did not.")
+	}
+	if !rotated.Matches(newToken, address) {
+		t.Errorf("Expected the new token to match, but it did not.")
+	}
+	if rotated.Matches(newToken, ethaddr.Dead()) {
+		t.Errorf("Did not expect the token to match a different eth-address, but it did.")
+	}
+	if old.Matches(newToken, address) {
+		t.Errorf("Did not expect the new token to match with only the old key, but it did.")
+	}
+}
+
+func TestNew_fail(t *testing.T) {
+
+	tests := []struct{
+		Keys []pseudo.Key
+		ExpectedError string
+	}{
+		{
+			Keys: []pseudo.Key{{ID: "", Secret: testKey1.Secret}},
+			ExpectedError: `pseudo: key #1 (""): pseudo: key ID must not be empty, and must not contain a colon`,
+		},
+		{
+			Keys: []pseudo.Key{{ID: "a:b", Secret: testKey1.Secret}},
+			ExpectedError: `pseudo: key #1 ("a:b"): pseudo: key ID must not be empty, and must not contain a colon`,
+		},
+		{
+			Keys: []pseudo.Key{testKey1, {ID: "k2", Secret: []byte("short")}},
+			ExpectedError: `pseudo: key #2 ("k2"): pseudo: key secret must be at least 16 bytes`,
+		},
+		{
+			Keys: []pseudo.Key{testKey1, testKey1},
+			ExpectedError: `pseudo: duplicate key ID "k1"`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, err := pseudo.New(test.Keys[0], test.Keys[1:]...)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			continue
+		}
+
+		if expected, actual := test.ExpectedError, err.Error(); expected != actual {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package pseudo
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/reiver/go-erorr"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+// Output is which kind of pseudonym CSV and JSON replace eth-addresses with.
+type Output int
+
+const (
+	OutputToken   Output = iota // see the Token method
+	OutputAddress               // see the Address method
+)
+
+func (receiver *Pseudonymizer) pseudonymize(text string, output Output) (string, error) {
+	address, err := ethaddr.ParseString(text)
+	if nil != err {
+		return "", err
+	}
+
+	if OutputAddress == output {
+		return receiver.Address(address).EIP55(), nil
+	}
+	return receiver.Token(address), nil
+}
+
+// CSV copies the CSV records from 'reader' to 'writer', replacing the eth-addresses in the named columns with pseudonyms.
+//
+// The first record must be a header, with the column names.
+// Empty cells (in the named columns) are left empty.
+//
+// For example:
+//
+//	err := pseudonymizer.CSV(writer, reader, pseudo.OutputToken, "from", "to")
+//
+// If a cell (in the named columns) is not an eth-address, then CSV returns an error (which says where it was, but does NOT include the cell).
+// In that case some records might have already been written.
+//
+// If the pseudonymizer has no keys (such as the zero value), then CSV returns an error (without reading or writing anything).
+func (receiver *Pseudonymizer) CSV(writer io.Writer, reader io.Reader, output Output, columns ...string) error {
+	if nil == receiver {
+		return errNilPseudonymizer
+	}
+	if len(receiver.keys) < 1 {
+		return errNoKeys
+	}
+
+	var csvReader *csv.Reader = csv.NewReader(reader)
+	var csvWriter *csv.Writer = csv.NewWriter(writer)
+
+	header, err := csvReader.Read()
+	if nil != err {
+		return erorr.Errorf("pseudo: problem reading CSV header: %w", err)
+	}
+
+	var indexes []int
+	for _, column := range columns {
+		var found bool
+		for index, name := range header {
+			if column == name {
+				indexes = append(indexes, index)
+				found = true
+			}
+		}
+		if !found {
+			return erorr.Errorf("pseudo: CSV column %q not found in header", column)
+		}
+	}
+
+	if err := csvWriter.Write(header); nil != err {
+		return err
+	}
+
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if nil != err {
+			return erorr.Errorf("pseudo: problem reading CSV: %w", err)
+		}
+
+		for _, index := range indexes {
+			if len(record) <= index || "" == record[index] {
+				continue
+			}
+
+			pseudonym, err := receiver.pseudonymize(record[index], output)
+			if nil != err {
+				line, _ := csvReader.FieldPos(index)
+				return erorr.Errorf("pseudo: CSV line %d, column %q: not an eth-address", line, header[index])
+			}
+			record[index] = pseudonym
+		}
+
+		if err := csvWriter.Write(record); nil != err {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// JSON copies the JSON values (such as a single JSON document, or JSON Lines) from 'reader' to 'writer',
+// replacing the eth-addresses in object members with the given names with pseudonyms.
+//
+// A member's value can be a string, an array of strings, or null.
+// Nulls and empty strings are left as they are (the same way CSV leaves empty cells empty).
+//
+// For example:
+//
+//	err := pseudonymizer.JSON(writer, reader, pseudo.OutputAddress, "from", "to")
+//
+// Each JSON value is written on its own line. Numbers are kept exactly as they were, but object members are written in sorted order.
+//
+// If a member (with one of the names) is not an eth-address, then JSON returns an error (which says which member, but does NOT include its value).
+//
+// If the pseudonymizer has no keys (such as the zero value), then JSON returns an error (without reading or writing anything).
+func (receiver *Pseudonymizer) JSON(writer io.Writer, reader io.Reader, output Output, names ...string) error {
+	if nil == receiver {
+		return errNilPseudonymizer
+	}
+	if len(receiver.keys) < 1 {
+		return errNoKeys
+	}
+
+	var set = map[string]struct{}{}
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+
+	var decoder *json.Decoder = json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	var encoder *json.Encoder = json.NewEncoder(writer)
+	encoder.SetEscapeHTML(false)
+
+	for valueNumber := 1; ; valueNumber++ {
+		var value interface{}
+		err := decoder.Decode(&value)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if nil != err {
+			return erorr.Errorf("pseudo: problem reading JSON value #%d: %w", valueNumber, err)
+		}
+
+		value, err = receiver.walkJSON(value, set, output)
+		if nil != err {
+			return erorr.Errorf("pseudo: JSON value #%d: %w", valueNumber, err)
+		}
+
+		if err := encoder.Encode(value); nil != err {
+			return err
+		}
+	}
+}
+
+func (receiver *Pseudonymizer) walkJSON(value interface{}, names map[string]struct{}, output Output) (interface{}, error) {
+	switch casted := value.(type) {
+	case map[string]interface{}:
+		for name, member := range casted {
+			var err error
+			if _, found := names[name]; found {
+				casted[name], err = receiver.replaceJSON(member, output)
+				if nil != err {
+					return nil, erorr.Errorf("member %q: %w", name, err)
+				}
+				continue
+			}
+
+			casted[name], err = receiver.walkJSON(member, names, output)
+			if nil != err {
+				return nil, err
+			}
+		}
+		return casted, nil
+	case []interface{}:
+		for index, element := range casted {
+			var err error
+			casted[index], err = receiver.walkJSON(element, names, output)
+			if nil != err {
+				return nil, err
+			}
+		}
+		return casted, nil
+	default:
+		return value, nil
+	}
+}
+
+func (receiver *Pseudonymizer) replaceJSON(value interface{}, output Output) (interface{}, error) {
+	switch casted := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if "" == casted {
+			return casted, nil
+		}
+		pseudonym, err := receiver.pseudonymize(casted, output)
+		if nil != err {
+			return nil, erorr.Error("not an eth-address")
+		}
+		return pseudonym, nil
+	case []interface{}:
+		for index, element := range casted {
+			var err error
+			casted[index], err = receiver.replaceJSON(element, output)
+			if nil != err {
+				return nil, erorr.Errorf("element #%d: %w", index, err)
+			}
+		}
+		return casted, nil
+	default:
+		return nil, erorr.Error("not an eth-address")
+	}
+}
@@ -0,0 +1,177 @@
+package pseudo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/reiver/go-ethaddr/pseudo"
+)
+
+func TestPseudonymizer_CSV(t *testing.T) {
+
+	pseudonymizer, err := pseudo.New(testKey1)
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: %s", err)
+	}
+
+	const input =
+		"from,to,amount\n"+
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed,0x000000000000000000000000000000000000dEaD,1.5\n"+
+		"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed,,2\n"
+
+	const expected =
+		"from,to,amount\n"+
+		"k1:6a62fab72e26ad2f716c854213ddf737,k1:8662f4d1adaba83ea102db7a30f065db,1.5\n"+
+		"k1:6a62fab72e26ad2f716c854213ddf737,,2\n"
+
+	var output strings.Builder
+	err = pseudonymizer.CSV(&output, strings.NewReader(input), pseudo.OutputToken, "from", "to")
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	if actual := output.String(); expected != actual {
+		t.Errorf("The actual CSV is not what was expected.")
+		t.Logf("EXPECTED:\n%s", expected)
+		t.Logf("ACTUAL:\n%s", actual)
+		return
+	}
+}
+
+func TestPseudonymizer_CSV_fail(t *testing.T) {
+
+	pseudonymizer, err := pseudo.New(testKey1)
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: %s", err)
+	}
+
+	tests := []struct{
+		Input string
+		Columns []string
+		ExpectedError string
+	}{
+		{
+			Input: "from,to\n0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed,alice\n",
+			Columns: []string{"from", "to"},
+			ExpectedError: `pseudo: CSV line 2, column "to": not an eth-address`,
+		},
+		{
+			Input: "from,to\n",
+			Columns: []string{"recipient"},
+			ExpectedError: `pseudo: CSV column "recipient" not found in header`,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		var output strings.Builder
+		err := pseudonymizer.CSV(&output, strings.NewReader(test.Input), pseudo.OutputToken, test.Columns...)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			continue
+		}
+
+		if expected, actual := test.ExpectedError, err.Error(); expected != actual {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+	}
+}
+
+func TestPseudonymizer_JSON(t *testing.T) {
+
+	pseudonymizer, err := pseudo.New(testKey1)
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: %s", err)
+	}
+
+	const input =
+		`{"to":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed","amount":12345678901234567890,"tx":{"from":null,"cc":["0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"]}}`+"\n"+
+		`[{"from":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed","memo":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}]`+"\n"+
+		`{"to":"","cc":["","0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"]}`+"\n"
+
+	const expected =
+		`{"amount":12345678901234567890,"to":"0xC546650bd19d7ea1E5a667FC28D610CA38C21781","tx":{"cc":["0xC546650bd19d7ea1E5a667FC28D610CA38C21781"],"from":null}}`+"\n"+
+		`[{"from":"0xC546650bd19d7ea1E5a667FC28D610CA38C21781","memo":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}]`+"\n"+
+		`{"cc":["","0xC546650bd19d7ea1E5a667FC28D610CA38C21781"],"to":""}`+"\n"
+
+	var output strings.Builder
+	err = pseudonymizer.JSON(&output, strings.NewReader(input), pseudo.OutputAddress, "from", "to", "cc")
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	if actual := output.String(); expected != actual {
+		t.Errorf("The actual JSON is not what was expected.")
+		t.Logf("EXPECTED:\n%s", expected)
+		t.Logf("ACTUAL:\n%s", actual)
+		return
+	}
+}
+
+func TestPseudonymizer_JSON_fail(t *testing.T) {
+
+	pseudonymizer, err := pseudo.New(testKey1)
+	if nil != err {
+		t.Fatalf("Did not expect an error but actually got one: %s", err)
+	}
+
+	const input = `{"to":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}`+"\n"+`{"to":["0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", 5]}`
+
+	var output strings.Builder
+	err = pseudonymizer.JSON(&output, strings.NewReader(input), pseudo.OutputToken, "to")
+	if nil == err {
+		t.Errorf("Expected an error but did not actually get one.")
+		return
+	}
+
+	if expected, actual := `pseudo: JSON value #2: member "to": element #1: not an eth-address`, err.Error(); expected != actual {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %s", expected)
+		t.Logf("ACTUAL:   %s", actual)
+		return
+	}
+}
+
+func TestPseudonymizer_zeroValue(t *testing.T) {
+
+	var pseudonymizer = &pseudo.Pseudonymizer{}
+
+	const expected = "pseudo: no keys"
+
+	{
+		var output strings.Builder
+		err := pseudonymizer.CSV(&output, strings.NewReader("to\n0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n"), pseudo.OutputToken, "to")
+		if nil == err {
+			t.Errorf("For CSV, expected an error but did not actually get one.")
+		} else if actual := err.Error(); expected != actual {
+			t.Errorf("For CSV, the actual error is not what was expected.")
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+		}
+		if "" != output.String() {
+			t.Errorf("For CSV, expected nothing to be written, but actually got: %q", output.String())
+		}
+	}
+
+	{
+		var output strings.Builder
+		err := pseudonymizer.JSON(&output, strings.NewReader(`{"to":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"}`), pseudo.OutputAddress, "to")
+		if nil == err {
+			t.Errorf("For JSON, expected an error but did not actually get one.")
+		} else if actual := err.Error(); expected != actual {
+			t.Errorf("For JSON, the actual error is not what was expected.")
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+		}
+		if "" != output.String() {
+			t.Errorf("For JSON, expected nothing to be written, but actually got: %q", output.String())
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package ethaddr
+
+import (
+	"hash/fnv"
+)
+
+// ShardStrategy is how a Sharder assigns eth-addresses to shards.
+type ShardStrategy int
+
+const (
+	// ShardJump uses jump consistent hashing.
+	//
+	// It is fast, and perfectly even, but only moves a minimal number of eth-addresses when shards are added to (or removed from) the END of the list.
+	//
+	// See: https://arxiv.org/abs/1406.2294
+	ShardJump ShardStrategy = iota
+
+	// ShardRendezvous uses rendezvous (highest random weight) hashing.
+	//
+	// It is slower (it is proportional to the number of shards), but only moves a minimal number of eth-addresses when ANY shard is added or removed.
+	// (Only the eth-addresses on a removed shard move, and only eth-addresses that move to an added shard move.)
+	ShardRendezvous
+)
+
+// String returns the name of the shard strategy.
+func (receiver ShardStrategy) String() string {
+	switch receiver {
+	case ShardJump:
+		return "jump"
+	case ShardRendezvous:
+		return "rendezvous"
+	default:
+		return "unknown"
+	}
+}
+
+// Sharder assigns eth-addresses to shards, such that when the shards change, a minimal number of eth-addresses move.
+//
+// For example:
+//
+//	var sharder = ethaddr.Sharder{
+//		Strategy: ethaddr.ShardRendezvous,
+//		Shards:   []string{"indexer-a", "indexer-b", "indexer-c"},
+//	}
+//	
+//	shard := sharder.Shard(address) // "indexer-b"
+//
+// Sharder uses Hash64 (with Seed), rather than (for example) the first byte of the eth-address, so that vanity eth-addresses and precompiles do not all end up on the same shard.
+//
+// For ShardRendezvous, each shard is identified by its name — and so the names should be unique.
+// For ShardJump, each shard is identified by its position in Shards.
+type Sharder struct {
+	Strategy ShardStrategy
+	Seed     uint64
+	Shards   []string
+}
+
+// Shard returns the name of the shard for the eth-address.
+//
+// If there are no shards, then Shard returns an empty string.
+func (receiver Sharder) Shard(address Address) string {
+	var index int = receiver.ShardIndex(address)
+	if index < 0 {
+		return ""
+	}
+
+	return receiver.Shards[index]
+}
+
+// ShardIndex returns the index (into Shards) of the shard for the eth-address.
+//
+// If there are no shards, then ShardIndex returns -1.
+func (receiver Sharder) ShardIndex(address Address) int {
+	if len(receiver.Shards) < 1 {
+		return -1
+	}
+
+	var h uint64 = address.Hash64(receiver.Seed)
+
+	switch receiver.Strategy {
+	case ShardRendezvous:
+		var best int = -1
+		var bestScore uint64
+		for index, name := range receiver.Shards {
+			var score uint64 = mix64(h ^ mix64(hashShardName(name)))
+			if best < 0 || bestScore < score {
+				best, bestScore = index, score
+			}
+		}
+		return best
+	default:
+		return jumpHash(h, len(receiver.Shards))
+	}
+}
+
+func hashShardName(name string) uint64 {
+	var hasher = fnv.New64a()
+	hasher.Write([]byte(name))
+	return hasher.Sum64()
+}
+
+// jumpHash returns the bucket (from 0 to numBuckets-1) for 'key', using jump consistent hashing.
+//
+// If numBuckets is less than 1, then jumpHash returns -1.
+//
+// See: https://arxiv.org/abs/1406.2294
+func jumpHash(key uint64, numBuckets int) int {
+	if numBuckets < 1 {
+		return -1
+	}
+
+	var b, j int64 = -1, 0
+	for j < int64(numBuckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+
+	return int(b)
+}
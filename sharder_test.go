@@ -0,0 +1,155 @@
+package ethaddr_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+// sharderTestAddresses returns vanity-like eth-addresses — which all share a long prefix, and only differ in their last few bytes.
+func sharderTestAddresses(count int) []ethaddr.Address {
+	var addresses []ethaddr.Address
+	for i:=0; i<count; i++ {
+		var value [ethaddr.AddressLength]byte
+		binary.BigEndian.PutUint32(value[ethaddr.AddressLength-4:], uint32(i))
+		addresses = append(addresses, ethaddr.Something(value))
+	}
+	return addresses
+}
+
+func sharderTestShards(count int) []string {
+	var shards []string
+	for i:=0; i<count; i++ {
+		shards = append(shards, fmt.Sprintf("shard-%d", i))
+	}
+	return shards
+}
+
+func TestSharder_even(t *testing.T) {
+
+	const numAddresses = 20000
+	const numShards = 10
+
+	var addresses []ethaddr.Address = sharderTestAddresses(numAddresses)
+
+	for _, strategy := range []ethaddr.ShardStrategy{ethaddr.ShardJump, ethaddr.ShardRendezvous} {
+
+		var sharder = ethaddr.Sharder{Strategy: strategy, Shards: sharderTestShards(numShards)}
+
+		var counts = map[string]int{}
+		for _, address := range addresses {
+			counts[sharder.Shard(address)]++
+		}
+
+		if expected, actual := numShards, len(counts); expected != actual {
+			t.Errorf("For strategy %s, the actual number of shards used is not what was expected.", strategy)
+			t.Logf("EXPECTED: %d", expected)
+			t.Logf("ACTUAL:   %d", actual)
+			continue
+		}
+
+		for shard, count := range counts {
+			const expected = numAddresses / numShards
+			if count < expected*9/10 || expected*11/10 < count {
+				t.Errorf("For strategy %s, shard %q has %d eth-addresses, which is too far from %d.", strategy, shard, count, expected)
+			}
+		}
+	}
+}
+
+func TestSharder_jump_minimalMovement(t *testing.T) {
+
+	var addresses []ethaddr.Address = sharderTestAddresses(20000)
+
+	var before = ethaddr.Sharder{Strategy: ethaddr.ShardJump, Seed: 7, Shards: sharderTestShards(10)}
+	var after  = ethaddr.Sharder{Strategy: ethaddr.ShardJump, Seed: 7, Shards: sharderTestShards(11)}
+
+	var moved int
+	for _, address := range addresses {
+		var b, a string = before.Shard(address), after.Shard(address)
+		if b == a {
+			continue
+		}
+		moved++
+
+		if expected := "shard-10"; expected != a {
+			t.Fatalf("An eth-address moved from %q to %q — but should only move to the new shard %q.", b, a, expected)
+		}
+	}
+
+	if expected := len(addresses) / 11; moved < expected*8/10 || expected*12/10 < moved {
+		t.Errorf("%d eth-addresses moved, which is too far from %d.", moved, expected)
+	}
+}
+
+func TestSharder_rendezvous_minimalMovement(t *testing.T) {
+
+	var addresses []ethaddr.Address = sharderTestAddresses(20000)
+
+	var shards []string = sharderTestShards(10)
+
+	var before = ethaddr.Sharder{Strategy: ethaddr.ShardRendezvous, Shards: shards}
+	// Remove a shard from the middle.
+	var after = ethaddr.Sharder{Strategy: ethaddr.ShardRendezvous, Shards: append(append([]string{}, shards[:4]...), shards[5:]...)}
+
+	var moved int
+	for _, address := range addresses {
+		var b, a string = before.Shard(address), after.Shard(address)
+		if b == a {
+			continue
+		}
+		moved++
+
+		if expected := "shard-4"; expected != b {
+			t.Fatalf("An eth-address moved from %q to %q — but only eth-addresses on the removed shard %q should move.", b, a, expected)
+		}
+	}
+
+	if expected := len(addresses) / 10; moved < expected*8/10 || expected*12/10 < moved {
+		t.Errorf("%d eth-addresses moved, which is too far from %d.", moved, expected)
+	}
+}
+
+func TestSharder_noShards(t *testing.T) {
+
+	var sharder ethaddr.Sharder
+
+	if expected, actual := -1, sharder.ShardIndex(ethaddr.Dead()); expected != actual {
+		t.Errorf("The actual shard index is not what was expected.")
+		t.Logf("EXPECTED: %d", expected)
+		t.Logf("ACTUAL:   %d", actual)
+	}
+	if expected, actual := "", sharder.Shard(ethaddr.Dead()); expected != actual {
+		t.Errorf("The actual shard is not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+	}
+}
+
+func TestSharder_ShardIndex_jump(t *testing.T) {
+
+	for _, address := range sharderTestAddresses(200) {
+
+		var sharder = ethaddr.Sharder{Strategy: ethaddr.ShardJump, Shards: sharderTestShards(1)}
+
+		var previous int = sharder.ShardIndex(address)
+		if 0 != previous {
+			t.Fatalf("Expected a single shard to always be shard 0, but actually got %d.", previous)
+		}
+
+		for n := 2; n <= 50; n++ {
+			sharder.Shards = sharderTestShards(n)
+
+			var index int = sharder.ShardIndex(address)
+			if index < 0 || n <= index {
+				t.Fatalf("Shard index %d is out of range for %d shards.", index, n)
+			}
+			if index != previous && n-1 != index {
+				t.Fatalf("Going from %d to %d shards moved an eth-address from shard %d to shard %d (rather than to the new shard).", n-1, n, previous, index)
+			}
+			previous = index
+		}
+	}
+}
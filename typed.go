@@ -0,0 +1,282 @@
+package ethaddr
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Typed is an eth-address with a role.
+//
+// The role is a type-parameter, that only exists at compile-time, so that eth-addresses with different roles cannot be mixed up by accident.
+// For example:
+//
+//	type TokenRole struct{}
+//	type WalletRole struct{}
+//	
+//	type TokenAddr  = ethaddr.Typed[TokenRole]
+//	type WalletAddr = ethaddr.Typed[WalletRole]
+//	
+//	func transfer(token TokenAddr, to WalletAddr, amount *big.Int) error {
+//		// ...
+//	}
+//
+// Here, passing a WalletAddr where a TokenAddr is expected (or vice versa) does not compile.
+//
+// Use WithRole to give an Address a role, Untyped to take it away, and As to change it.
+//
+// Typed forwards all of the marshaling and unmarshaling of Address — and so is encoded exactly the same way as an Address is.
+// (For example, Typed works with "encoding/json" through MarshalText and UnmarshalText, just like Address does.)
+type Typed[R any] struct {
+	address Address
+}
+
+// WithRole returns 'address' with the role R.
+//
+// For example:
+//
+//	var token ethaddr.Typed[TokenRole] = ethaddr.WithRole[TokenRole](address)
+func WithRole[R any](address Address) Typed[R] {
+	return Typed[R]{address: address}
+}
+
+// As changes the role of 'typed' from R1 to R2.
+//
+// For example:
+//
+//	var wallet ethaddr.Typed[WalletRole] = ethaddr.As[WalletRole](token)
+func As[R2 any, R1 any](typed Typed[R1]) Typed[R2] {
+	return Typed[R2]{address: typed.address}
+}
+
+// Untyped returns the eth-address without its role.
+func (receiver Typed[R]) Untyped() Address {
+	return receiver.address
+}
+
+// Bytes is the same as the Bytes method on Address.
+func (receiver Typed[R]) Bytes() []byte {
+	return receiver.address.Bytes()
+}
+
+// BigInt is the same as the BigInt method on Address.
+func (receiver Typed[R]) BigInt() *big.Int {
+	return receiver.address.BigInt()
+}
+
+// EIP55 is the same as the EIP55 method on Address.
+func (receiver Typed[R]) EIP55() string {
+	return receiver.address.EIP55()
+}
+
+// Get is the same as the Get method on Address.
+func (receiver Typed[R]) Get() ([AddressLength]byte, bool) {
+	return receiver.address.Get()
+}
+
+// GetElse is the same as the GetElse method on Address.
+func (receiver Typed[R]) GetElse(alternative [AddressLength]byte) [AddressLength]byte {
+	return receiver.address.GetElse(alternative)
+}
+
+// GoString makes it so Typed implements fmt.GoStringer.
+//
+// For example:
+//
+//	ethaddr.WithRole[main.TokenRole](ethaddr.Something([20]uint8{0x5a, 0xae, ...}))
+func (receiver Typed[R]) GoString() string {
+	return fmt.Sprintf("ethaddr.WithRole[%s](%s)", reflect.TypeOf((*R)(nil)).Elem(), receiver.address.GoString())
+}
+
+// IsNothing is the same as the IsNothing method on Address.
+func (receiver Typed[R]) IsNothing() bool {
+	return receiver.address.IsNothing()
+}
+
+// IsSomething is the same as the IsSomething method on Address.
+func (receiver Typed[R]) IsSomething() bool {
+	return receiver.address.IsSomething()
+}
+
+// IsZero is the same as the IsZero method on Address.
+func (receiver Typed[R]) IsZero() bool {
+	return receiver.address.IsZero()
+}
+
+// Kind is the same as the Kind method on Address.
+func (receiver Typed[R]) Kind() Kind {
+	return receiver.address.Kind()
+}
+
+// LogValue is the same as the LogValue method on Address.
+func (receiver Typed[R]) LogValue() slog.Value {
+	return receiver.address.LogValue()
+}
+
+// String is the same as the String method on Address.
+func (receiver Typed[R]) String() string {
+	return receiver.address.String()
+}
+
+// AppendCBOR is the same as the AppendCBOR method on Address.
+func (receiver Typed[R]) AppendCBOR(dst []byte) []byte {
+	return receiver.address.AppendCBOR(dst)
+}
+
+// AppendCBORText is the same as the AppendCBORText method on Address.
+func (receiver Typed[R]) AppendCBORText(dst []byte) []byte {
+	return receiver.address.AppendCBORText(dst)
+}
+
+// AppendOptionalBinary is the same as the AppendOptionalBinary method on Address.
+func (receiver Typed[R]) AppendOptionalBinary(dst []byte) []byte {
+	return receiver.address.AppendOptionalBinary(dst)
+}
+
+// GobEncode is the same as the GobEncode method on Address.
+func (receiver Typed[R]) GobEncode() ([]byte, error) {
+	return receiver.address.GobEncode()
+}
+
+// MarshalBinary is the same as the MarshalBinary method on Address.
+func (receiver Typed[R]) MarshalBinary() ([]byte, error) {
+	return receiver.address.MarshalBinary()
+}
+
+// MarshalBSONValue is the same as the MarshalBSONValue method on Address.
+func (receiver Typed[R]) MarshalBSONValue() (byte, []byte, error) {
+	return receiver.address.MarshalBSONValue()
+}
+
+// MarshalCBOR is the same as the MarshalCBOR method on Address.
+func (receiver Typed[R]) MarshalCBOR() ([]byte, error) {
+	return receiver.address.MarshalCBOR()
+}
+
+// MarshalGQL is the same as the MarshalGQL method on Address.
+func (receiver Typed[R]) MarshalGQL(writer io.Writer) {
+	receiver.address.MarshalGQL(writer)
+}
+
+// MarshalOptionalBinary is the same as the MarshalOptionalBinary method on Address.
+func (receiver Typed[R]) MarshalOptionalBinary() ([]byte, error) {
+	return receiver.address.MarshalOptionalBinary()
+}
+
+// MarshalText is the same as the MarshalText method on Address.
+func (receiver Typed[R]) MarshalText() ([]byte, error) {
+	return receiver.address.MarshalText()
+}
+
+// MarshalTOML is the same as the MarshalTOML method on Address.
+func (receiver Typed[R]) MarshalTOML() ([]byte, error) {
+	return receiver.address.MarshalTOML()
+}
+
+// MarshalXML is the same as the MarshalXML method on Address.
+func (receiver Typed[R]) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return receiver.address.MarshalXML(encoder, start)
+}
+
+// MarshalXMLAttr is the same as the MarshalXMLAttr method on Address.
+func (receiver Typed[R]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return receiver.address.MarshalXMLAttr(name)
+}
+
+// MarshalYAML is the same as the MarshalYAML method on Address.
+func (receiver Typed[R]) MarshalYAML() (interface{}, error) {
+	return receiver.address.MarshalYAML()
+}
+
+// GobDecode is the same as the GobDecode method on Address.
+func (receiver *Typed[R]) GobDecode(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	return receiver.address.GobDecode(data)
+}
+
+// UnmarshalBinary is the same as the UnmarshalBinary method on Address.
+func (receiver *Typed[R]) UnmarshalBinary(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	return receiver.address.UnmarshalBinary(data)
+}
+
+// UnmarshalBSONValue is the same as the UnmarshalBSONValue method on Address.
+func (receiver *Typed[R]) UnmarshalBSONValue(bsontype byte, data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	return receiver.address.UnmarshalBSONValue(bsontype, data)
+}
+
+// UnmarshalCBOR is the same as the UnmarshalCBOR method on Address.
+func (receiver *Typed[R]) UnmarshalCBOR(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	return receiver.address.UnmarshalCBOR(data)
+}
+
+// UnmarshalGQL is the same as the UnmarshalGQL method on Address.
+func (receiver *Typed[R]) UnmarshalGQL(value interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	return receiver.address.UnmarshalGQL(value)
+}
+
+// UnmarshalOptionalBinary is the same as the UnmarshalOptionalBinary method on Address.
+func (receiver *Typed[R]) UnmarshalOptionalBinary(data []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	return receiver.address.UnmarshalOptionalBinary(data)
+}
+
+// UnmarshalText is the same as the UnmarshalText method on Address.
+func (receiver *Typed[R]) UnmarshalText(text []byte) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	return receiver.address.UnmarshalText(text)
+}
+
+// UnmarshalTOML is the same as the UnmarshalTOML method on Address.
+func (receiver *Typed[R]) UnmarshalTOML(value interface{}) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	return receiver.address.UnmarshalTOML(value)
+}
+
+// UnmarshalXML is the same as the UnmarshalXML method on Address.
+func (receiver *Typed[R]) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	return receiver.address.UnmarshalXML(decoder, start)
+}
+
+// UnmarshalXMLAttr is the same as the UnmarshalXMLAttr method on Address.
+func (receiver *Typed[R]) UnmarshalXMLAttr(attr xml.Attr) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	return receiver.address.UnmarshalXMLAttr(attr)
+}
+
+// UnmarshalYAML is the same as the UnmarshalYAML method on Address.
+func (receiver *Typed[R]) UnmarshalYAML(value *yaml.Node) error {
+	if nil == receiver {
+		return errNilReceiver
+	}
+	return receiver.address.UnmarshalYAML(value)
+}
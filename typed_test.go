@@ -0,0 +1,143 @@
+package ethaddr_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+type typedTestTokenRole struct{}
+type typedTestWalletRole struct{}
+
+func TestTyped(t *testing.T) {
+
+	var address ethaddr.Address = ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	var token ethaddr.Typed[typedTestTokenRole] = ethaddr.WithRole[typedTestTokenRole](address)
+	var wallet ethaddr.Typed[typedTestWalletRole] = ethaddr.As[typedTestWalletRole](token)
+
+	if expected, actual := address, token.Untyped(); expected != actual {
+		t.Errorf("The actual untyped token address is not what was expected.")
+		t.Logf("EXPECTED: %#v", expected)
+		t.Logf("ACTUAL:   %#v", actual)
+		return
+	}
+	if expected, actual := address, wallet.Untyped(); expected != actual {
+		t.Errorf("The actual untyped wallet address is not what was expected.")
+		t.Logf("EXPECTED: %#v", expected)
+		t.Logf("ACTUAL:   %#v", actual)
+		return
+	}
+
+	if expected, actual := address.EIP55(), wallet.String(); expected != actual {
+		t.Errorf("The actual string is not what was expected.")
+		t.Logf("EXPECTED: %q", expected)
+		t.Logf("ACTUAL:   %q", actual)
+		return
+	}
+
+	if expected, actual := ethaddr.KindRegular, token.Kind(); expected != actual {
+		t.Errorf("The actual kind is not what was expected.")
+		t.Logf("EXPECTED: %s", expected)
+		t.Logf("ACTUAL:   %s", actual)
+		return
+	}
+
+	{
+		const expected = "ethaddr.WithRole[ethaddr_test.typedTestTokenRole](ethaddr.Something([20]uint8{0x5a, 0xae, 0xb6, 0x5, 0x3f, 0x3e, 0x94, 0xc9, 0xb9, 0xa0, 0x9f, 0x33, 0x66, 0x94, 0x35, 0xe7, 0xef, 0x1b, 0xea, 0xed}))"
+		actual := token.GoString()
+
+		if expected != actual {
+			t.Errorf("The actual go-string is not what was expected.")
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			return
+		}
+	}
+
+	var nothing ethaddr.Typed[typedTestTokenRole]
+	if !nothing.IsNothing() {
+		t.Errorf("Expected the zero-value of Typed to be nothing, but it was not.")
+		return
+	}
+}
+
+func TestTyped_json(t *testing.T) {
+
+	type transfer struct {
+		Token ethaddr.Typed[typedTestTokenRole]  `json:"token"`
+		To    ethaddr.Typed[typedTestWalletRole] `json:"to"`
+	}
+
+	var value = transfer{
+		Token: ethaddr.WithRole[typedTestTokenRole](ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")),
+		To:    ethaddr.WithRole[typedTestWalletRole](ethaddr.Dead()),
+	}
+
+	data, err := json.Marshal(value)
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	{
+		const expected = `{"token":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed","to":"0x000000000000000000000000000000000000dEaD"}`
+		actual := string(data)
+
+		if expected != actual {
+			t.Errorf("The actual JSON is not what was expected.")
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			return
+		}
+	}
+
+	var actual transfer
+	err = json.Unmarshal(data, &actual)
+	if nil != err {
+		t.Errorf("Did not expect an error when unmarshaling but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	if expected := value; expected != actual {
+		t.Errorf("The actual unmarshaled value is not what was expected.")
+		t.Logf("EXPECTED: %#v", expected)
+		t.Logf("ACTUAL:   %#v", actual)
+		return
+	}
+}
+
+func TestTyped_validateStruct(t *testing.T) {
+
+	type transfer struct {
+		Token ethaddr.Typed[typedTestTokenRole]  `ethaddr:"required,nonzero"`
+		To    ethaddr.Typed[typedTestWalletRole] `ethaddr:"required"`
+	}
+
+	var value = transfer{
+		Token: ethaddr.WithRole[typedTestTokenRole](ethaddr.Zero()),
+	}
+
+	err := ethaddr.ValidateStruct(value)
+	if nil == err {
+		t.Errorf("Expected an error but did not actually get one.")
+		return
+	}
+
+	{
+		const expected =
+			"ethaddr: transfer.Token: eth-address must not be the zero eth-address\n"+
+			"ethaddr: transfer.To: eth-address is required"
+		actual := err.Error()
+
+		if expected != actual {
+			t.Errorf("The actual error is not what was expected.")
+			t.Logf("EXPECTED:\n%s", expected)
+			t.Logf("ACTUAL:\n%s", actual)
+			return
+		}
+	}
+}
@@ -3,7 +3,6 @@ package ethaddr
 import (
 	"bytes"
 
-	"github.com/reiver/go-erorr"
 	"github.com/reiver/go-hexadeca"
 )
 
@@ -28,7 +27,7 @@ func unmarshalText(dst *[AddressLength]byte, text []byte) error {
 	var hex []byte
 	{
 		if !bytes.HasPrefix(text, hexlitprefix[:]) {
-			return errMissingHexadecimalLiteralPrefix
+			return ErrMissingPrefix
 		}
 
 		hex = text[len(hexlitprefix):]
@@ -51,7 +50,7 @@ func unmarshalText(dst *[AddressLength]byte, text []byte) error {
 
 				decoded0, ok = hexadeca.DecodeByte(hex0)
 				if !ok {
-					return erorr.Errorf("ethaddr: byte number-0 (after \"0x\" prefix) of hexadecimal literal (%d) (%q) is not a valid hexadecimal symbol" , hex0, hex0)
+					return newParseError(ErrBadHexadecimalSymbol, "ethaddr: byte number-0 (after \"0x\" prefix) of hexadecimal literal (%d) (%q) is not a valid hexadecimal symbol" , hex0, hex0)
 				}
 			}
 
@@ -61,7 +60,7 @@ func unmarshalText(dst *[AddressLength]byte, text []byte) error {
 
 				decoded1, ok = hexadeca.DecodeByte(hex1)
 				if !ok {
-					return erorr.Errorf("ethaddr: byte number-1 (after \"0x\" prefix) of hexadecimal literal (%d) (%q) is not a valid hexadecimal symbol" , hex1, hex1)
+					return newParseError(ErrBadHexadecimalSymbol, "ethaddr: byte number-1 (after \"0x\" prefix) of hexadecimal literal (%d) (%q) is not a valid hexadecimal symbol" , hex1, hex1)
 				}
 			}
 
@@ -80,7 +79,7 @@ func unmarshalText(dst *[AddressLength]byte, text []byte) error {
 
 				decoded0, ok = hexadeca.DecodeByte(hex0)
 				if !ok {
-					return erorr.Errorf("ethaddr: byte number-0 (after \"0x\" prefix) of hexadecimal literal (%d) (%q) is not a valid hexadecimal symbol" , hex0, hex0)
+					return newParseError(ErrBadHexadecimalSymbol, "ethaddr: byte number-0 (after \"0x\" prefix) of hexadecimal literal (%d) (%q) is not a valid hexadecimal symbol" , hex0, hex0)
 				}
 			}
 
@@ -91,7 +90,7 @@ func unmarshalText(dst *[AddressLength]byte, text []byte) error {
 			var expected1 int = AddressLength*2 + len(hexlitprefix)
 			var expected2 int = expected1 - 1
 
-			return erorr.Errorf("ethaddr: the eth-address is expected to be %d or %d bytes long, but was actually %d bytes long", expected1, expected2, len(text))
+			return newParseError(ErrBadLength, "ethaddr: the eth-address is expected to be %d or %d bytes long, but was actually %d bytes long", expected1, expected2, len(text))
 		}
 	}
 
@@ -109,12 +108,12 @@ func unmarshalText(dst *[AddressLength]byte, text []byte) error {
 
 			mostSignificant, ok := hexadeca.DecodeByte(mostSignificantHex)
 			if !ok {
-				return erorr.Errorf("ethaddr: byte number-%d (after \"0x\" prefix) of hexadecimal literal (%d) (%q) is not a valid hexadecimal symbol" , numHandled+indexToMostSignificant, mostSignificantHex, mostSignificantHex)
+				return newParseError(ErrBadHexadecimalSymbol, "ethaddr: byte number-%d (after \"0x\" prefix) of hexadecimal literal (%d) (%q) is not a valid hexadecimal symbol" , numHandled+indexToMostSignificant, mostSignificantHex, mostSignificantHex)
 			}
 
 			leastSignificant, ok := hexadeca.DecodeByte(leastSignificantHex)
 			if !ok {
-				return erorr.Errorf("ethaddr: byte number-%d (after \"0x\" prefix) of hexadecimal literal (%d) (%q) is not a valid hexadecimal symbol" , numHandled+indexToLeastSignificant, leastSignificantHex, leastSignificantHex)
+				return newParseError(ErrBadHexadecimalSymbol, "ethaddr: byte number-%d (after \"0x\" prefix) of hexadecimal literal (%d) (%q) is not a valid hexadecimal symbol" , numHandled+indexToLeastSignificant, leastSignificantHex, leastSignificantHex)
 			}
 
 			var value byte = (mostSignificant << 4) | leastSignificant
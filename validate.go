@@ -0,0 +1,315 @@
+package ethaddr
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/reiver/go-erorr"
+)
+
+// These are the rules that can be used in an `ethaddr:"..."` struct-tag.
+//
+// See ValidateStruct.
+const (
+	RuleRequired      = "required"
+	RuleNonZero       = "nonzero"
+	RuleNonPrecompile = "nonprecompile"
+	RuleChecksum      = "checksum"
+)
+
+// FieldError is a single problem found by ValidateStruct.
+//
+// Path is the path to the field, such as "Payment.Recipients[2]".
+// Rule is the rule that was broken (or "" if the field could not be parsed).
+type FieldError struct {
+	Path string
+	Rule string
+	Err  error
+}
+
+// Error makes it so FieldError is an error.
+func (receiver FieldError) Error() string {
+	var message string = "<nil>"
+	if nil != receiver.Err {
+		message = strings.TrimPrefix(receiver.Err.Error(), "ethaddr: ")
+	}
+
+	return "ethaddr: " + receiver.Path + ": " + message
+}
+
+// Unwrap returns the underlying error.
+func (receiver FieldError) Unwrap() error {
+	return receiver.Err
+}
+
+// ValidationErrors is every problem found by ValidateStruct.
+type ValidationErrors []FieldError
+
+// Error makes it so ValidationErrors is an error.
+//
+// Each problem is on its own line.
+func (receiver ValidationErrors) Error() string {
+	var builder strings.Builder
+	for index, fieldError := range receiver {
+		if 0 < index {
+			builder.WriteByte('\n')
+		}
+		builder.WriteString(fieldError.Error())
+	}
+
+	return builder.String()
+}
+
+// Unwrap returns each of the problems, so that errors.Is and errors.As can be used with ValidationErrors.
+func (receiver ValidationErrors) Unwrap() []error {
+	var errs []error = make([]error, 0, len(receiver))
+	for _, fieldError := range receiver {
+		errs = append(errs, fieldError)
+	}
+
+	return errs
+}
+
+var addressType = reflect.TypeOf(Address{})
+
+// untyper is implemented by Typed.
+type untyper interface {
+	Untyped() Address
+}
+
+var untyperType = reflect.TypeOf((*untyper)(nil)).Elem()
+
+// ValidateStruct checks the fields of the struct (or pointer to a struct) 'v' that have an `ethaddr:"..."` struct-tag.
+//
+// For example:
+//
+//	type Payment struct {
+//		From  ethaddr.Address   `ethaddr:"required,nonzero"`
+//		To    string            `ethaddr:"required,nonzero,nonprecompile,checksum"`
+//		CC  []ethaddr.Address   `ethaddr:"nonzero"`
+//	}
+//	
+//	err := ethaddr.ValidateStruct(payment)
+//
+// The rules are:
+//
+//	required       must not be Nothing() (or, for a string, must not be empty)
+//	nonzero        must not be the zero eth-address (0x0000000000000000000000000000000000000000)
+//	nonprecompile  must not be a precompile (see KindPrecompile)
+//	checksum       must be in EIP-55 / ERC-55 form — this rule only applies to string fields, since an Address does not remember how it was written
+//
+// A tagged field can be an Address, a Typed, a string (which is parsed the same way Parse does), a pointer to either, or a slice or array of any of those.
+// (For a slice or array, the rules apply to each element.)
+// Nested structs (and pointers to structs, and slices and arrays of structs) are checked too, even if they are not tagged.
+// Each pointer (and slice) is only followed once, so cyclic data (such as a struct that points back to itself) is fine.
+//
+// If there are any problems, then ValidateStruct returns a ValidationErrors that lists every one of them.
+func ValidateStruct(v interface{}) error {
+	var visited = map[visit]struct{}{}
+
+	var value reflect.Value = reflect.ValueOf(v)
+	for reflect.Pointer == value.Kind() {
+		if value.IsNil() {
+			return errNilStruct
+		}
+		visited[newVisit(value)] = struct{}{}
+		value = value.Elem()
+	}
+
+	if reflect.Struct != value.Kind() {
+		return erorr.Errorf("ethaddr: expected a struct (or pointer to a struct), but actually got %T", v)
+	}
+
+	var validationErrors ValidationErrors
+	validateStruct(&validationErrors, visited, value.Type().Name(), value)
+	if 0 < len(validationErrors) {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// visit identifies a pointer (or slice) that has already been followed, so that cyclic data (such as a linked-list whose last node points back to its first) does not recurse forever.
+type visit struct {
+	pointer uintptr
+	length  int
+	typ     reflect.Type
+}
+
+// newVisit returns the visit for 'value' (which must be a pointer or a slice).
+func newVisit(value reflect.Value) visit {
+	var length int
+	if reflect.Slice == value.Kind() {
+		length = value.Len()
+	}
+
+	return visit{
+		pointer: value.Pointer(),
+		length: length,
+		typ: value.Type(),
+	}
+}
+
+// validateStruct checks each field of 'value' (which must be a struct).
+func validateStruct(validationErrors *ValidationErrors, visited map[visit]struct{}, path string, value reflect.Value) {
+	var typ reflect.Type = value.Type()
+
+	for i:=0; i<typ.NumField(); i++ {
+		var field reflect.StructField = typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		var fieldPath string = field.Name
+		if "" != path {
+			fieldPath = path + "." + field.Name
+		}
+
+		tag, tagged := field.Tag.Lookup("ethaddr")
+		if !tagged {
+			validateNested(validationErrors, visited, fieldPath, value.Field(i))
+			continue
+		}
+
+		var rules []string
+		for _, rule := range strings.Split(tag, ",") {
+			rule = strings.TrimSpace(rule)
+			if "" == rule {
+				continue
+			}
+			switch rule {
+			case RuleRequired, RuleNonZero, RuleNonPrecompile, RuleChecksum:
+				rules = append(rules, rule)
+			default:
+				*validationErrors = append(*validationErrors, FieldError{Path: fieldPath, Rule: rule, Err: erorr.Errorf("ethaddr: unknown rule %q", rule)})
+			}
+		}
+
+		validateField(validationErrors, fieldPath, value.Field(i), rules)
+	}
+}
+
+// validateNested checks any structs in an untagged field.
+//
+// Each pointer (and slice) is only followed once — anything reached again (through a cycle) has already been checked.
+func validateNested(validationErrors *ValidationErrors, visited map[visit]struct{}, path string, value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Slice:
+		if value.IsNil() {
+			return
+		}
+		var key visit = newVisit(value)
+		if _, found := visited[key]; found {
+			return
+		}
+		visited[key] = struct{}{}
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return
+		}
+		validateNested(validationErrors, visited, path, value.Elem())
+	case reflect.Struct:
+		if addressType == value.Type() || value.Type().Implements(untyperType) {
+			return
+		}
+		validateStruct(validationErrors, visited, path, value)
+	case reflect.Slice, reflect.Array:
+		for i:=0; i<value.Len(); i++ {
+			validateNested(validationErrors, visited, path+"["+strconv.Itoa(i)+"]", value.Index(i))
+		}
+	}
+}
+
+// validateField applies the rules to a tagged field.
+func validateField(validationErrors *ValidationErrors, path string, value reflect.Value, rules []string) {
+	report := func(rule string, err error) {
+		*validationErrors = append(*validationErrors, FieldError{Path: path, Rule: rule, Err: err})
+	}
+
+	var isString bool
+	var text string
+	var address Address
+
+	switch {
+	case addressType == value.Type():
+		address = value.Interface().(Address)
+	case reflect.Struct == value.Kind() && value.Type().Implements(untyperType):
+		address = value.Interface().(untyper).Untyped()
+	case reflect.String == value.Kind():
+		isString = true
+		text = value.String()
+	case reflect.Pointer == value.Kind() && (addressType == value.Type().Elem() || value.Type().Elem().Implements(untyperType) || reflect.String == value.Type().Elem().Kind()):
+		if value.IsNil() {
+			if hasRule(rules, RuleRequired) {
+				report(RuleRequired, errRequired)
+			}
+			return
+		}
+		validateField(validationErrors, path, value.Elem(), rules)
+		return
+	case reflect.Slice == value.Kind() || reflect.Array == value.Kind():
+		for i:=0; i<value.Len(); i++ {
+			validateField(validationErrors, path+"["+strconv.Itoa(i)+"]", value.Index(i), rules)
+		}
+		return
+	default:
+		report("", erorr.Errorf("ethaddr: the ethaddr struct-tag cannot be used on a field of type %s", value.Type()))
+		return
+	}
+
+	if isString {
+		if "" == text {
+			if hasRule(rules, RuleRequired) {
+				report(RuleRequired, errRequired)
+			}
+			return
+		}
+
+		var err error
+		address, err = ParseString(text)
+		if nil != err {
+			report("", err)
+			return
+		}
+	}
+
+	if address.IsNothing() {
+		if hasRule(rules, RuleRequired) {
+			report(RuleRequired, errRequired)
+		}
+		return
+	}
+
+	for _, rule := range rules {
+		switch rule {
+		case RuleNonZero:
+			if KindZero == address.Kind() {
+				report(rule, errZeroAddress)
+			}
+		case RuleNonPrecompile:
+			if KindPrecompile == address.Kind() {
+				report(rule, errPrecompileAddress)
+			}
+		case RuleChecksum:
+			switch {
+			case !isString:
+				report(rule, erorr.Errorf("ethaddr: the %q rule can only be used on a string field, but this field is of type %s", RuleChecksum, value.Type()))
+			case address.EIP55() != text:
+				report(rule, erorr.Errorf("ethaddr: eth-address %q is not in EIP-55 / ERC-55 form (%s)", text, address.EIP55()))
+			}
+		}
+	}
+}
+
+func hasRule(rules []string, rule string) bool {
+	for _, r := range rules {
+		if rule == r {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+package ethaddr_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+type validateTestRecipient struct {
+	Wallet ethaddr.Address `ethaddr:"required,nonzero,nonprecompile"`
+}
+
+type validateTestPayment struct {
+	From       ethaddr.Address    `ethaddr:"required,nonzero"`
+	To         string             `ethaddr:"required,checksum"`
+	Refund     *ethaddr.Address   `ethaddr:"nonzero"`
+	CC         []ethaddr.Address  `ethaddr:"nonprecompile"`
+	Recipients []validateTestRecipient
+	Note       string
+	unexported ethaddr.Address    `ethaddr:"required"`
+}
+
+func TestValidateStruct(t *testing.T) {
+
+	var refund ethaddr.Address = ethaddr.Dead()
+
+	var payment = validateTestPayment{
+		From: ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+		To: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		Refund: &refund,
+		CC: []ethaddr.Address{ethaddr.Zero()},
+		Recipients: []validateTestRecipient{
+			{Wallet: ethaddr.ParseStringElsePanic("0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB")},
+		},
+	}
+
+	if err := ethaddr.ValidateStruct(payment); nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	if err := ethaddr.ValidateStruct(&payment); nil != err {
+		t.Errorf("Did not expect an error (for a pointer) but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+}
+
+func TestValidateStruct_fail(t *testing.T) {
+
+	var refund ethaddr.Address = ethaddr.Zero()
+
+	var payment = validateTestPayment{
+		From: ethaddr.Nothing(),
+		To: "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+		Refund: &refund,
+		CC: []ethaddr.Address{ethaddr.Dead(), ethaddr.Precompile(0x01)},
+		Recipients: []validateTestRecipient{
+			{Wallet: ethaddr.ParseStringElsePanic("0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB")},
+			{Wallet: ethaddr.Nothing()},
+			{Wallet: ethaddr.Zero()},
+		},
+	}
+
+	err := ethaddr.ValidateStruct(payment)
+	if nil == err {
+		t.Errorf("Expected an error but did not actually get one.")
+		return
+	}
+
+	{
+		const expected =
+			"ethaddr: validateTestPayment.From: eth-address is required\n"+
+			"ethaddr: validateTestPayment.To: eth-address \"0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359\" is not in EIP-55 / ERC-55 form (0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359)\n"+
+			"ethaddr: validateTestPayment.Refund: eth-address must not be the zero eth-address\n"+
+			"ethaddr: validateTestPayment.CC[1]: eth-address must not be a precompile\n"+
+			"ethaddr: validateTestPayment.Recipients[1].Wallet: eth-address is required\n"+
+			"ethaddr: validateTestPayment.Recipients[2].Wallet: eth-address must not be the zero eth-address"
+
+		actual := err.Error()
+
+		if expected != actual {
+			t.Errorf("The actual error is not what was expected.")
+			t.Logf("EXPECTED:\n%s", expected)
+			t.Logf("ACTUAL:\n%s", actual)
+			return
+		}
+	}
+
+	var validationErrors ethaddr.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Errorf("Expected the error to be an ethaddr.ValidationErrors, but it was not.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	{
+		var expected = []struct{
+			Path string
+			Rule string
+		}{
+			{"validateTestPayment.From", "required"},
+			{"validateTestPayment.To", "checksum"},
+			{"validateTestPayment.Refund", "nonzero"},
+			{"validateTestPayment.CC[1]", "nonprecompile"},
+			{"validateTestPayment.Recipients[1].Wallet", "required"},
+			{"validateTestPayment.Recipients[2].Wallet", "nonzero"},
+		}
+
+		if len(expected) != len(validationErrors) {
+			t.Errorf("The actual number of field-errors is not what was expected.")
+			t.Logf("EXPECTED: %d", len(expected))
+			t.Logf("ACTUAL:   %d", len(validationErrors))
+			return
+		}
+
+		for index, fieldError := range validationErrors {
+			if expected[index].Path != fieldError.Path || expected[index].Rule != fieldError.Rule {
+				t.Errorf("For field-error #%d, the actual path and rule are not what was expected.", index)
+				t.Logf("EXPECTED: %s %s", expected[index].Path, expected[index].Rule)
+				t.Logf("ACTUAL:   %s %s", fieldError.Path, fieldError.Rule)
+			}
+		}
+	}
+}
+
+type validateTestNode struct {
+	Addr ethaddr.Address `ethaddr:"required"`
+	Next *validateTestNode
+	Kids []validateTestNode
+}
+
+// TestValidateStruct_cycle checks that cyclic data is validated (rather than recursed into forever).
+func TestValidateStruct_cycle(t *testing.T) {
+
+	var first = &validateTestNode{Addr: ethaddr.Dead()}
+	var second = &validateTestNode{Addr: ethaddr.Nothing(), Next: first}
+	first.Next = second
+
+	// A slice whose element refers back to the slice itself.
+	var kids = make([]validateTestNode, 1)
+	kids[0].Kids = kids
+	first.Kids = kids
+
+	err := ethaddr.ValidateStruct(first)
+	if nil == err {
+		t.Errorf("Expected an error but did not actually get one.")
+		return
+	}
+
+	{
+		const expected =
+			"ethaddr: validateTestNode.Next.Addr: eth-address is required\n"+
+			"ethaddr: validateTestNode.Kids[0].Addr: eth-address is required"
+
+		actual := err.Error()
+
+		if expected != actual {
+			t.Errorf("The actual error is not what was expected.")
+			t.Logf("EXPECTED:\n%s", expected)
+			t.Logf("ACTUAL:\n%s", actual)
+			return
+		}
+	}
+
+	// A node that points to itself.
+	var self = &validateTestNode{Addr: ethaddr.Dead()}
+	self.Next = self
+
+	if err := ethaddr.ValidateStruct(self); nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+}
+
+func TestValidateStruct_misuse(t *testing.T) {
+
+	tests := []struct{
+		Value interface{}
+		ExpectedError string
+	}{
+		{
+			Value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			ExpectedError: "ethaddr: expected a struct (or pointer to a struct), but actually got string",
+		},
+		{
+			Value: (*validateTestPayment)(nil),
+			ExpectedError: "ethaddr: nil struct",
+		},
+		{
+			Value: struct{ A int `ethaddr:"required"` }{},
+			ExpectedError: "ethaddr: A: the ethaddr struct-tag cannot be used on a field of type int",
+		},
+		{
+			Value: struct{ A ethaddr.Address `ethaddr:"checksum"` }{A: ethaddr.Dead()},
+			ExpectedError: "ethaddr: A: the \"checksum\" rule can only be used on a string field, but this field is of type ethaddr.Address",
+		},
+		{
+			Value: struct{ A ethaddr.Address `ethaddr:"requried"` }{},
+			ExpectedError: "ethaddr: A: unknown rule \"requried\"",
+		},
+		{
+			Value: struct{ A string `ethaddr:"nonzero"` }{A: "0xdead"},
+			ExpectedError: "ethaddr: A: the eth-address is expected to be 42 or 41 bytes long, but was actually 6 bytes long",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		err := ethaddr.ValidateStruct(test.Value)
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			continue
+		}
+
+		if expected, actual := test.ExpectedError, err.Error(); expected != actual {
+			t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+	}
+}
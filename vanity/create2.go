@@ -0,0 +1,43 @@
+package vanity
+
+import (
+	"golang.org/x/crypto/sha3"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+// CREATE2Address returns the eth-address of the contract that a CREATE2 deploy would create.
+//
+// I.e.,:
+//
+//	keccak256(0xff ++ deployer ++ salt ++ initCodeHash)[12:]
+//
+// If 'deployer' is nothing, then CREATE2Address returns nothing.
+func CREATE2Address(deployer ethaddr.Address, salt [32]byte, initCodeHash [32]byte) ethaddr.Address {
+	value, something := deployer.Get()
+	if !something {
+		return ethaddr.Nothing()
+	}
+
+	return ethaddr.Something(create2(value, salt, initCodeHash))
+}
+
+func create2(deployer [ethaddr.AddressLength]byte, salt [32]byte, initCodeHash [32]byte) [ethaddr.AddressLength]byte {
+	var buffer [1 + ethaddr.AddressLength + 32 + 32]byte
+	{
+		buffer[0] = 0xff
+		p := buffer[1:]
+		p = p[copy(p, deployer[:]):]
+		p = p[copy(p, salt[:]):]
+		copy(p, initCodeHash[:])
+	}
+
+	hashfunc := sha3.NewLegacyKeccak256()
+	hashfunc.Write(buffer[:])
+	digest := hashfunc.Sum(nil)
+
+	var address [ethaddr.AddressLength]byte
+	copy(address[:], digest[len(digest)-ethaddr.AddressLength:])
+
+	return address
+}
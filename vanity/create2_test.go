@@ -0,0 +1,85 @@
+package vanity_test
+
+import (
+	"testing"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/reiver/go-ethaddr"
+	"github.com/reiver/go-ethaddr/vanity"
+)
+
+func keccak256(data []byte) [32]byte {
+	var digest [32]byte
+
+	hashfunc := sha3.NewLegacyKeccak256()
+	hashfunc.Write(data)
+	copy(digest[:], hashfunc.Sum(nil))
+
+	return digest
+}
+
+// The test vectors are from EIP-1014.
+func TestCREATE2Address(t *testing.T) {
+
+	tests := []struct{
+		Deployer ethaddr.Address
+		Salt [32]byte
+		InitCode []byte
+		Expected string
+	}{
+		{
+			Deployer: ethaddr.ParseStringElsePanic("0x0000000000000000000000000000000000000000"),
+			Salt: [32]byte{},
+			InitCode: []byte{0x00},
+			Expected: "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38",
+		},
+		{
+			Deployer: ethaddr.ParseStringElsePanic("0xdeadbeef00000000000000000000000000000000"),
+			Salt: [32]byte{},
+			InitCode: []byte{0x00},
+			Expected: "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3",
+		},
+		{
+			Deployer: ethaddr.ParseStringElsePanic("0xdeadbeef00000000000000000000000000000000"),
+			Salt: [32]byte{0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0xfe,0xed,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00},
+			InitCode: []byte{0x00},
+			Expected: "0xD04116cDd17beBE565EB2422F2497E06cC1C9833",
+		},
+		{
+			Deployer: ethaddr.ParseStringElsePanic("0x0000000000000000000000000000000000000000"),
+			Salt: [32]byte{},
+			InitCode: []byte{0xde,0xad,0xbe,0xef},
+			Expected: "0x70f2b2914A2a4b783FaEFb75f459A580616Fcb5e",
+		},
+		{
+			Deployer: ethaddr.ParseStringElsePanic("0x00000000000000000000000000000000deadbeef"),
+			Salt: [32]byte{0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0xca,0xfe,0xba,0xbe},
+			InitCode: []byte{0xde,0xad,0xbe,0xef},
+			Expected: "0x60f3f640a8508fC6a86d45DF051962668E1e8AC7",
+		},
+		{
+			Deployer: ethaddr.ParseStringElsePanic("0x0000000000000000000000000000000000000000"),
+			Salt: [32]byte{},
+			InitCode: []byte{},
+			Expected: "0xE33C0C7F7df4809055C3ebA6c09CFe4BaF1BD9e0",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := vanity.CREATE2Address(test.Deployer, test.Salt, keccak256(test.InitCode)).String()
+
+		expected := test.Expected
+
+		if expected != actual {
+			t.Errorf("For test #%d, the actual CREATE2 eth-address is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			t.Logf("DEPLOYER: %s", test.Deployer)
+			t.Logf("SALT: %X", test.Salt)
+			t.Logf("INIT-CODE: %X", test.InitCode)
+			continue
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package vanity
+
+import (
+	"github.com/reiver/go-erorr"
+)
+
+const (
+	errEmptyPattern     = erorr.Error("vanity: empty pattern")
+	errNothingDeployer  = erorr.Error("vanity: deployer eth-address is nothing")
+	errPatternTooLong   = erorr.Error("vanity: pattern is longer than an eth-address")
+)
@@ -0,0 +1,38 @@
+package vanity
+
+import (
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+// KeyAddress returns the eth-address of the externally-owned-account (EOA) controlled by the (secp256k1) private-key.
+//
+// I.e.,:
+//
+//	keccak256(uncompressed-public-key-without-0x04-prefix)[12:]
+func KeyAddress(privateKey [32]byte) ethaddr.Address {
+	return ethaddr.Something(keyAddress(privateKey))
+}
+
+func keyAddress(privateKey [32]byte) [ethaddr.AddressLength]byte {
+	var publicKey []byte = secp256k1.PrivKeyFromBytes(privateKey[:]).PubKey().SerializeUncompressed()
+
+	hashfunc := sha3.NewLegacyKeccak256()
+	hashfunc.Write(publicKey[1:])
+	digest := hashfunc.Sum(nil)
+
+	var address [ethaddr.AddressLength]byte
+	copy(address[:], digest[len(digest)-ethaddr.AddressLength:])
+
+	return address
+}
+
+// validPrivateKey returns true if 'privateKey' is in the range [1, n-1], where n is the order of the secp256k1 curve.
+func validPrivateKey(privateKey [32]byte) bool {
+	var scalar secp256k1.ModNScalar
+	overflow := scalar.SetBytes(&privateKey)
+
+	return 0 == overflow && !scalar.IsZero()
+}
@@ -0,0 +1,42 @@
+package vanity_test
+
+import (
+	"testing"
+
+	"github.com/reiver/go-ethaddr/vanity"
+)
+
+func TestKeyAddress(t *testing.T) {
+
+	tests := []struct{
+		PrivateKey [32]byte
+		Expected string
+	}{
+		{
+			PrivateKey: [32]byte{0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x01},
+			Expected: "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+		},
+		{
+			PrivateKey: [32]byte{0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x02},
+			Expected: "0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF",
+		},
+		{
+			PrivateKey: [32]byte{0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x03},
+			Expected: "0x6813Eb9362372EEF6200f3b1dbC3f819671cBA69",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := vanity.KeyAddress(test.PrivateKey).String()
+
+		expected := test.Expected
+
+		if expected != actual {
+			t.Errorf("For test #%d, the actual eth-address is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+	}
+}
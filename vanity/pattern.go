@@ -0,0 +1,173 @@
+package vanity
+
+import (
+	"math"
+
+	"github.com/reiver/go-eip55"
+	"github.com/reiver/go-erorr"
+	"github.com/reiver/go-ethaddr"
+)
+
+// Wildcard is the symbol that can be used in Pattern.Template to match any hexadecimal-symbol.
+const Wildcard = '?'
+
+const hexLength = ethaddr.AddressLength * 2
+
+// Pattern describes what a vanity eth-address should look like.
+//
+// Prefix, Suffix, and Template are all hexadecimal (WITHOUT the "0x" prefix).
+//
+// For example:
+//
+//	var pattern = vanity.Pattern{
+//		Prefix: "dead",
+//		Suffix: "beef",
+//	}
+//
+// And also, for example:
+//
+//	var pattern = vanity.Pattern{
+//		Template: "c0ffee??????????????????????????????????",
+//	}
+//
+// If CaseSensitive is true, then the letters ("a" to "f" and "A" to "F") in the pattern are matched against the EIP-55 / ERC-55 encoding of the eth-address.
+// If CaseSensitive is false, then the letter-case is ignored.
+type Pattern struct {
+	Prefix        string
+	Suffix        string
+	Template      string
+	CaseSensitive bool
+}
+
+// compiledPattern is a Pattern flattened into a single template the same length as the hexadecimal-literal of an eth-address.
+type compiledPattern struct {
+	template      [hexLength]byte
+	caseSensitive bool
+}
+
+func (receiver Pattern) compile() (compiledPattern, error) {
+	var compiled compiledPattern
+	compiled.caseSensitive = receiver.CaseSensitive
+
+	for i := range compiled.template {
+		compiled.template[i] = Wildcard
+	}
+
+	if "" == receiver.Prefix && "" == receiver.Suffix && "" == receiver.Template {
+		return compiled, errEmptyPattern
+	}
+
+	for _, part := range []struct{
+		Name   string
+		Value  string
+		Offset int
+	}{
+		{Name: "prefix",   Value: receiver.Prefix,   Offset: 0},
+		{Name: "suffix",   Value: receiver.Suffix,   Offset: hexLength - len(receiver.Suffix)},
+		{Name: "template", Value: receiver.Template, Offset: 0},
+	} {
+		if hexLength < len(part.Value) {
+			return compiled, errPatternTooLong
+		}
+
+		for i:=0; i<len(part.Value); i++ {
+			var b byte = part.Value[i]
+			var index int = part.Offset + i
+
+			if Wildcard == b {
+				continue
+			}
+
+			if !isHex(b) {
+				return compiled, erorr.Errorf("vanity: byte number-%d of the %s (%q) is not a valid hexadecimal symbol", i, part.Name, b)
+			}
+
+			if !receiver.CaseSensitive {
+				b = toLower(b)
+			}
+
+			if existing := compiled.template[index]; Wildcard != existing && existing != b {
+				return compiled, erorr.Errorf("vanity: the %s conflicts with another part of the pattern at hexadecimal-symbol number-%d (%q versus %q)", part.Name, index, b, existing)
+			}
+
+			compiled.template[index] = b
+		}
+	}
+
+	return compiled, nil
+}
+
+// Difficulty returns the expected number of attempts it would take to find an eth-address that matches the pattern.
+//
+// Each fixed hexadecimal-symbol multiplies the difficulty by 16.
+// If the pattern is case-sensitive, then each fixed letter additionally multiplies the difficulty by 2.
+func (receiver Pattern) Difficulty() (float64, error) {
+	compiled, err := receiver.compile()
+	if nil != err {
+		return 0, err
+	}
+
+	return compiled.difficulty(), nil
+}
+
+func (receiver compiledPattern) difficulty() float64 {
+	var difficulty float64 = 1
+
+	for _, b := range receiver.template {
+		switch {
+		case Wildcard == b:
+			// nothing here.
+		case receiver.caseSensitive && !('0' <= b && b <= '9'):
+			difficulty *= 32
+		default:
+			difficulty *= 16
+		}
+	}
+
+	return difficulty
+}
+
+// probability returns the probability that a single attempt matches the pattern.
+func (receiver compiledPattern) probability() float64 {
+	return 1 / receiver.difficulty()
+}
+
+// chance returns the probability of having found a match after 'attempts' number of attempts.
+func (receiver compiledPattern) chance(attempts uint64) float64 {
+	return -math.Expm1(float64(attempts) * math.Log1p(-receiver.probability()))
+}
+
+func (receiver compiledPattern) match(address [ethaddr.AddressLength]byte) bool {
+	var hex [hexLength]byte
+	if receiver.caseSensitive {
+		copy(hex[:], eip55.Encode(address)[2:])
+	} else {
+		const symbols string = "0123456789abcdef"
+		for i, b := range address {
+			hex[i*2]   = symbols[b>>4]
+			hex[i*2+1] = symbols[b&0x0F]
+		}
+	}
+
+	for i, b := range receiver.template {
+		if Wildcard == b {
+			continue
+		}
+		if hex[i] != b {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isHex(b byte) bool {
+	return ('0' <= b && b <= '9') || ('a' <= b && b <= 'f') || ('A' <= b && b <= 'F')
+}
+
+func toLower(b byte) byte {
+	if 'A' <= b && b <= 'F' {
+		return b - 'A' + 'a'
+	}
+	return b
+}
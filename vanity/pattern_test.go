@@ -0,0 +1,124 @@
+package vanity_test
+
+import (
+	"testing"
+
+	"github.com/reiver/go-ethaddr/vanity"
+)
+
+func TestPattern_Difficulty(t *testing.T) {
+
+	tests := []struct{
+		Pattern vanity.Pattern
+		Expected float64
+	}{
+		{
+			Pattern: vanity.Pattern{Prefix:"0"},
+			Expected: 16,
+		},
+		{
+			Pattern: vanity.Pattern{Prefix:"dead"},
+			Expected: 16*16*16*16,
+		},
+		{
+			Pattern: vanity.Pattern{Prefix:"dead", Suffix:"beef"},
+			Expected: 16*16*16*16 * 16*16*16*16,
+		},
+		{
+			Pattern: vanity.Pattern{Prefix:"dead", Template:"de??"},
+			Expected: 16*16*16*16,
+		},
+		{
+			Pattern: vanity.Pattern{Template:"1??1"},
+			Expected: 16*16,
+		},
+
+
+
+		{
+			Pattern: vanity.Pattern{Prefix:"00", CaseSensitive:true},
+			Expected: 16*16,
+		},
+		{
+			Pattern: vanity.Pattern{Prefix:"dEaD", CaseSensitive:true},
+			Expected: 32*32*32*32,
+		},
+		{
+			Pattern: vanity.Pattern{Prefix:"C0fFee", CaseSensitive:true},
+			Expected: 32*16*32*32*32*32,
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual, err := test.Pattern.Difficulty()
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("PATTERN: %#v", test.Pattern)
+			continue
+		}
+
+		expected := test.Expected
+
+		if expected != actual {
+			t.Errorf("For test #%d, the actual difficulty is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %v", expected)
+			t.Logf("ACTUAL:   %v", actual)
+			t.Logf("PATTERN: %#v", test.Pattern)
+			continue
+		}
+	}
+}
+
+func TestPattern_Difficulty_fail(t *testing.T) {
+
+	tests := []struct{
+		Pattern vanity.Pattern
+		ExpectedError string
+	}{
+		{
+			Pattern: vanity.Pattern{},
+			ExpectedError: "vanity: empty pattern",
+		},
+		{
+			Pattern: vanity.Pattern{Prefix:"0x00"},
+			ExpectedError: "vanity: byte number-1 of the prefix ('x') is not a valid hexadecimal symbol",
+		},
+		{
+			Pattern: vanity.Pattern{Suffix:"beeg"},
+			ExpectedError: "vanity: byte number-3 of the suffix ('g') is not a valid hexadecimal symbol",
+		},
+		{
+			Pattern: vanity.Pattern{Prefix:"dead", Template:"beef"},
+			ExpectedError: "vanity: the template conflicts with another part of the pattern at hexadecimal-symbol number-0 ('b' versus 'd')",
+		},
+		{
+			Pattern: vanity.Pattern{Prefix:"00000000000000000000000000000000000000000"},
+			ExpectedError: "vanity: pattern is longer than an eth-address",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		_, err := test.Pattern.Difficulty()
+		if nil == err {
+			t.Errorf("For test #%d, expected an error but did not actually get one.", testNumber)
+			t.Logf("PATTERN: %#v", test.Pattern)
+			continue
+		}
+
+		{
+			expected := test.ExpectedError
+			actual := err.Error()
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual error is not what was expected.", testNumber)
+				t.Logf("EXPECTED: %q", expected)
+				t.Logf("ACTUAL:   %q", actual)
+				t.Logf("PATTERN: %#v", test.Pattern)
+				continue
+			}
+		}
+	}
+}
@@ -0,0 +1,283 @@
+package vanity
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+// Config configures a search.
+//
+// The zero value is usable.
+//
+// If Workers is zero (or negative), then runtime.NumCPU() goroutines are used.
+//
+// If Progress is not nil, then it is called (from its own goroutine) every ProgressInterval while the search is running.
+// If ProgressInterval is zero (or negative), then it defaults to 1 second.
+type Config struct {
+	Workers          int
+	Progress         func(Progress)
+	ProgressInterval time.Duration
+}
+
+func (receiver Config) workers() int {
+	if receiver.Workers <= 0 {
+		return runtime.NumCPU()
+	}
+	return receiver.Workers
+}
+
+func (receiver Config) progressInterval() time.Duration {
+	if receiver.ProgressInterval <= 0 {
+		return time.Second
+	}
+	return receiver.ProgressInterval
+}
+
+// Progress reports how a search is going.
+//
+// Difficulty is the expected number of attempts it takes to find a match.
+//
+// Chance is the probability that a match would have been found by now, given the number of attempts made so far.
+type Progress struct {
+	Attempts   uint64
+	Elapsed    time.Duration
+	Difficulty float64
+	Chance     float64
+}
+
+// Rate returns the number of attempts per second.
+func (receiver Progress) Rate() float64 {
+	var seconds float64 = receiver.Elapsed.Seconds()
+	if seconds <= 0 {
+		return 0
+	}
+
+	return float64(receiver.Attempts) / seconds
+}
+
+// CREATE2Result is what SearchCREATE2 found.
+type CREATE2Result struct {
+	Address  ethaddr.Address
+	Salt     [32]byte
+	Attempts uint64
+}
+
+// KeyResult is what SearchKey found.
+//
+// Keep PrivateKey secret!
+type KeyResult struct {
+	Address    ethaddr.Address
+	PrivateKey [32]byte
+	Attempts   uint64
+}
+
+// SearchCREATE2 searches for a CREATE2 salt such that the contract deployed by 'deployer', with init-code whose keccak256 hash is 'initCodeHash', has an eth-address that matches 'pattern'.
+//
+// SearchCREATE2 keeps searching until it finds a match, or until 'ctx' is done.
+//
+// For example:
+//
+//	result, err := vanity.SearchCREATE2(ctx, deployer, initCodeHash, vanity.Pattern{Prefix:"c0ffee"}, vanity.Config{})
+func SearchCREATE2(ctx context.Context, deployer ethaddr.Address, initCodeHash [32]byte, pattern Pattern, config Config) (CREATE2Result, error) {
+	deployerValue, something := deployer.Get()
+	if !something {
+		return CREATE2Result{}, errNothingDeployer
+	}
+
+	newAttempter := func() (attempter, error) {
+		var salt [32]byte
+		if _, err := rand.Read(salt[:]); nil != err {
+			return nil, err
+		}
+
+		return func() ([ethaddr.AddressLength]byte, [32]byte, bool, error) {
+			var counter uint64 = binary.BigEndian.Uint64(salt[24:])
+			binary.BigEndian.PutUint64(salt[24:], counter+1)
+
+			return create2(deployerValue, salt, initCodeHash), salt, true, nil
+		}, nil
+	}
+
+	address, salt, attempts, err := search(ctx, pattern, config, newAttempter)
+	if nil != err {
+		return CREATE2Result{Attempts: attempts}, err
+	}
+
+	return CREATE2Result{
+		Address: ethaddr.Something(address),
+		Salt: salt,
+		Attempts: attempts,
+	}, nil
+}
+
+// SearchKey searches for a (secp256k1) private-key whose externally-owned-account (EOA) eth-address matches 'pattern'.
+//
+// The private-keys are generated using "crypto/rand".
+//
+// SearchKey keeps searching until it finds a match, until 'ctx' is done, or until "crypto/rand" fails (in which case its error is returned).
+//
+// For example:
+//
+//	result, err := vanity.SearchKey(ctx, vanity.Pattern{Prefix:"dead", Suffix:"beef"}, vanity.Config{Workers:8})
+func SearchKey(ctx context.Context, pattern Pattern, config Config) (KeyResult, error) {
+
+	newAttempter := func() (attempter, error) {
+		return func() ([ethaddr.AddressLength]byte, [32]byte, bool, error) {
+			var privateKey [32]byte
+			if _, err := rand.Read(privateKey[:]); nil != err {
+				return [ethaddr.AddressLength]byte{}, privateKey, false, err
+			}
+			if !validPrivateKey(privateKey) {
+				return [ethaddr.AddressLength]byte{}, privateKey, false, nil
+			}
+
+			return keyAddress(privateKey), privateKey, true, nil
+		}, nil
+	}
+
+	address, privateKey, attempts, err := search(ctx, pattern, config, newAttempter)
+	if nil != err {
+		return KeyResult{Attempts: attempts}, err
+	}
+
+	return KeyResult{
+		Address: ethaddr.Something(address),
+		PrivateKey: privateKey,
+		Attempts: attempts,
+	}, nil
+}
+
+// attempter returns the eth-address and secret (salt or private-key) of a single attempt, and false if the attempt should be skipped.
+//
+// If an attempter returns an error, then the whole search stops, and returns that error.
+type attempter func() ([ethaddr.AddressLength]byte, [32]byte, bool, error)
+
+// search runs the workers.
+//
+// Each worker gets its own attempter (from 'newAttempter').
+func search(parent context.Context, pattern Pattern, config Config, newAttempter func() (attempter, error)) ([ethaddr.AddressLength]byte, [32]byte, uint64, error) {
+	var nada [ethaddr.AddressLength]byte
+
+	compiled, err := pattern.compile()
+	if nil != err {
+		return nada, [32]byte{}, 0, err
+	}
+
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	type found struct {
+		address [ethaddr.AddressLength]byte
+		secret  [32]byte
+		err     error
+	}
+
+	var attempts atomic.Uint64
+	var once sync.Once
+	var result found
+
+	var waitgroup sync.WaitGroup
+
+	// All the attempters are created before any worker is started, so that no worker is left running if creating one of them fails.
+	var attempters []attempter
+	for i, limit := 0, config.workers(); i<limit; i++ {
+		attempt, err := newAttempter()
+		if nil != err {
+			return nada, [32]byte{}, 0, err
+		}
+		attempters = append(attempters, attempt)
+	}
+
+	for _, attempt := range attempters {
+		waitgroup.Add(1)
+		go func() {
+			defer waitgroup.Done()
+
+			// Attempts are counted in batches, so that the workers do not all contend over the same counter.
+			const batch = 256
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+
+				for j:=0; j<batch; j++ {
+					address, secret, ok, err := attempt()
+					if nil != err {
+						attempts.Add(uint64(j))
+						once.Do(func() {
+							result = found{err: err}
+							cancel()
+						})
+						return
+					}
+					if !ok {
+						continue
+					}
+					if compiled.match(address) {
+						attempts.Add(uint64(j+1))
+						once.Do(func() {
+							result = found{address: address, secret: secret}
+							cancel()
+						})
+						return
+					}
+				}
+				attempts.Add(batch)
+			}
+		}()
+	}
+
+	if nil != config.Progress {
+		var start time.Time = time.Now()
+		var difficulty float64 = compiled.difficulty()
+
+		waitgroup.Add(1)
+		go func() {
+			defer waitgroup.Done()
+
+			ticker := time.NewTicker(config.progressInterval())
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					var n uint64 = attempts.Load()
+					config.Progress(Progress{
+						Attempts: n,
+						Elapsed: time.Since(start),
+						Difficulty: difficulty,
+						Chance: compiled.chance(n),
+					})
+				}
+			}
+		}()
+	}
+
+	waitgroup.Wait()
+
+	var matched bool = true
+	once.Do(func() {
+		// If we get here, then no worker found a match, so the parent context must be done.
+		matched = false
+	})
+	if !matched {
+		return nada, [32]byte{}, attempts.Load(), parent.Err()
+	}
+	if nil != result.err {
+		return nada, [32]byte{}, attempts.Load(), result.err
+	}
+
+	return result.address, result.secret, attempts.Load(), nil
+}
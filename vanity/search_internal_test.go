@@ -0,0 +1,68 @@
+package vanity
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/reiver/go-ethaddr"
+	"github.com/reiver/go-erorr"
+)
+
+func TestSearch_attempterError(t *testing.T) {
+
+	const errRandom = erorr.Error("random failure")
+
+	newAttempter := func() (attempter, error) {
+		return func() ([ethaddr.AddressLength]byte, [32]byte, bool, error) {
+			return [ethaddr.AddressLength]byte{}, [32]byte{}, false, errRandom
+		}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, _, _, err := search(ctx, Pattern{Prefix:"dead"}, Config{Workers:4}, newAttempter)
+	if expected, actual := error(errRandom), err; expected != actual {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %v", expected)
+		t.Logf("ACTUAL:   %v", actual)
+	}
+	if nil != ctx.Err() {
+		t.Errorf("Expected the search to stop because of the error, not because the context was done.")
+	}
+}
+
+func TestSearch_newAttempterError(t *testing.T) {
+
+	const errRandom = erorr.Error("random failure")
+
+	var started atomic.Int32
+
+	var count int
+	newAttempter := func() (attempter, error) {
+		count++
+		if 3 <= count {
+			return nil, errRandom
+		}
+		return func() ([ethaddr.AddressLength]byte, [32]byte, bool, error) {
+			started.Add(1)
+			return [ethaddr.AddressLength]byte{}, [32]byte{}, false, nil
+		}, nil
+	}
+
+	_, _, _, err := search(context.Background(), Pattern{Prefix:"dead"}, Config{Workers:4}, newAttempter)
+	if expected, actual := error(errRandom), err; expected != actual {
+		t.Errorf("The actual error is not what was expected.")
+		t.Logf("EXPECTED: %v", expected)
+		t.Logf("ACTUAL:   %v", actual)
+	}
+
+	// Give any (wrongly) started worker a chance to run.
+	time.Sleep(10*time.Millisecond)
+
+	if expected, actual := int32(0), started.Load(); expected != actual {
+		t.Errorf("Expected no worker to have been started, but %d attempts were made.", actual)
+	}
+}
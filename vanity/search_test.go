@@ -0,0 +1,144 @@
+package vanity_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reiver/go-ethaddr"
+	"github.com/reiver/go-ethaddr/vanity"
+)
+
+func TestSearchCREATE2(t *testing.T) {
+
+	deployer := ethaddr.ParseStringElsePanic("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	initCodeHash := keccak256([]byte{0xde,0xad,0xbe,0xef})
+
+	tests := []struct{
+		Pattern vanity.Pattern
+	}{
+		{
+			Pattern: vanity.Pattern{Prefix:"00"},
+		},
+		{
+			Pattern: vanity.Pattern{Prefix:"a", Suffix:"b"},
+		},
+		{
+			Pattern: vanity.Pattern{Prefix:"Ab", CaseSensitive:true},
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		result, err := vanity.SearchCREATE2(context.Background(), deployer, initCodeHash, test.Pattern, vanity.Config{Workers:2})
+		if nil != err {
+			t.Errorf("For test #%d, did not expect an error but actually got one.", testNumber)
+			t.Logf("ERROR: (%T) %s", err, err)
+			t.Logf("PATTERN: %#v", test.Pattern)
+			continue
+		}
+
+		{
+			expected := vanity.CREATE2Address(deployer, result.Salt, initCodeHash)
+			actual := result.Address
+
+			if expected != actual {
+				t.Errorf("For test #%d, the actual eth-address does not match the salt.", testNumber)
+				t.Logf("EXPECTED: %s", expected)
+				t.Logf("ACTUAL:   %s", actual)
+				t.Logf("SALT: %X", result.Salt)
+				continue
+			}
+		}
+
+		{
+			var hex string = result.Address.String()[2:]
+			if !test.Pattern.CaseSensitive {
+				hex = strings.ToLower(hex)
+			}
+
+			if !strings.HasPrefix(hex, test.Pattern.Prefix) || !strings.HasSuffix(hex, test.Pattern.Suffix) {
+				t.Errorf("For test #%d, the actual eth-address does not match the pattern.", testNumber)
+				t.Logf("ADDRESS: %s", result.Address)
+				t.Logf("PATTERN: %#v", test.Pattern)
+				continue
+			}
+		}
+
+		if result.Attempts < 1 {
+			t.Errorf("For test #%d, expected at least 1 attempt but actually got %d.", testNumber, result.Attempts)
+			continue
+		}
+	}
+}
+
+func TestSearchKey(t *testing.T) {
+
+	var pattern = vanity.Pattern{Prefix:"7"}
+
+	result, err := vanity.SearchKey(context.Background(), pattern, vanity.Config{Workers:2})
+	if nil != err {
+		t.Errorf("Did not expect an error but actually got one.")
+		t.Logf("ERROR: (%T) %s", err, err)
+		return
+	}
+
+	{
+		expected := vanity.KeyAddress(result.PrivateKey)
+		actual := result.Address
+
+		if expected != actual {
+			t.Errorf("The actual eth-address does not match the private-key.")
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			return
+		}
+	}
+
+	if !strings.HasPrefix(result.Address.String(), "0x7") {
+		t.Errorf("The actual eth-address does not match the pattern.")
+		t.Logf("ADDRESS: %s", result.Address)
+		return
+	}
+}
+
+func TestSearchCREATE2_cancel(t *testing.T) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	var progressed bool
+
+	var pattern = vanity.Pattern{Prefix:"0000000000000000000000000000000000000000"}
+	var config = vanity.Config{
+		Workers: 2,
+		ProgressInterval: 5*time.Millisecond,
+		Progress: func(vanity.Progress) {
+			progressed = true
+		},
+	}
+
+	_, err := vanity.SearchCREATE2(ctx, ethaddr.Zero(), [32]byte{}, pattern, config)
+	if nil == err {
+		t.Errorf("Expected an error but did not actually get one.")
+		return
+	}
+
+	{
+		expected := context.DeadlineExceeded
+		actual := err
+
+		if expected != actual {
+			t.Errorf("The actual error is not what was expected.")
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			return
+		}
+	}
+
+	if !progressed {
+		t.Errorf("Expected progress to have been reported.")
+		return
+	}
+}
@@ -0,0 +1,117 @@
+package ethaddr
+
+// Zero returns the zero eth-address.
+//
+// I.e.,:
+//
+//	0x0000000000000000000000000000000000000000
+//
+// Note that Zero is NOT the same thing as Nothing.
+// And, because IsZero is about the zero-value of the Go type (which is Nothing), ethaddr.Zero().IsZero() returns false.
+// To check for the zero eth-address, use:
+//
+//	address.Kind() == ethaddr.KindZero
+func Zero() Address {
+	return Something([AddressLength]byte{})
+}
+
+// NativeTokenSentinel returns the eth-address that is commonly used (by DEX-aggregators, bridges, etc) as a placeholder for the native-token (ex: ETH) of a network.
+//
+// I.e.,:
+//
+//	0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE
+func NativeTokenSentinel() Address {
+	return Something([AddressLength]byte{0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE,0xEE})
+}
+
+// Dead returns the eth-address that is commonly used to burn tokens.
+//
+// I.e.,:
+//
+//	0x000000000000000000000000000000000000dEaD
+func Dead() Address {
+	return Something([AddressLength]byte{0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0x00,0xdE,0xaD})
+}
+
+// BeaconDepositContract returns the eth-address of the (Ethereum mainnet) beacon-chain deposit-contract.
+//
+// I.e.,:
+//
+//	0x00000000219ab540356cBB839Cbe05303d7705Fa
+func BeaconDepositContract() Address {
+	return Something([AddressLength]byte{0x00,0x00,0x00,0x00,0x21,0x9a,0xb5,0x40,0x35,0x6c,0xBB,0x83,0x9C,0xbe,0x05,0x30,0x3d,0x77,0x05,0xFa})
+}
+
+// SystemAddress returns the eth-address that the execution-layer uses as the caller when it makes system-calls (EIP-4788, EIP-2935, EIP-7002, EIP-7251).
+//
+// I.e.,:
+//
+//	0xffffFFFfFFffffffffffffffFfFFFfffFFFfFFfE
+func SystemAddress() Address {
+	return Something([AddressLength]byte{0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFF,0xFE})
+}
+
+// BeaconRootsContract returns the eth-address of the EIP-4788 beacon-block-root contract.
+//
+// I.e.,:
+//
+//	0x000F3df6D732807Ef1319fB7B8bB8522d0Beac02
+func BeaconRootsContract() Address {
+	return Something([AddressLength]byte{0x00,0x0F,0x3d,0xf6,0xD7,0x32,0x80,0x7E,0xf1,0x31,0x9f,0xB7,0xB8,0xbB,0x85,0x22,0xd0,0xBe,0xac,0x02})
+}
+
+// HistoryStorageContract returns the eth-address of the EIP-2935 historical-block-hashes contract.
+//
+// I.e.,:
+//
+//	0x0000F90827F1C53a10cb7A02335B175320002935
+func HistoryStorageContract() Address {
+	return Something([AddressLength]byte{0x00,0x00,0xF9,0x08,0x27,0xF1,0xC5,0x3a,0x10,0xcb,0x7A,0x02,0x33,0x5B,0x17,0x53,0x20,0x00,0x29,0x35})
+}
+
+// WithdrawalRequestContract returns the eth-address of the EIP-7002 execution-layer-triggerable-withdrawals contract.
+//
+// I.e.,:
+//
+//	0x00000961Ef480Eb55e80D19ad83579A64c007002
+func WithdrawalRequestContract() Address {
+	return Something([AddressLength]byte{0x00,0x00,0x09,0x61,0xEf,0x48,0x0E,0xb5,0x5e,0x80,0xD1,0x9a,0xd8,0x35,0x79,0xA6,0x4c,0x00,0x70,0x02})
+}
+
+// ConsolidationRequestContract returns the eth-address of the EIP-7251 consolidation-request contract.
+//
+// I.e.,:
+//
+//	0x0000BBdDc7CE488642fb579F8B00f3a590007251
+func ConsolidationRequestContract() Address {
+	return Something([AddressLength]byte{0x00,0x00,0xBB,0xdD,0xc7,0xCE,0x48,0x86,0x42,0xfb,0x57,0x9F,0x8B,0x00,0xf3,0xa5,0x90,0x00,0x72,0x51})
+}
+
+// P256VerifyPrecompile is the number of the RIP-7212 (secp256r1 / P-256 signature verification) precompile, that some L2 networks have.
+//
+// I.e., it is the precompile with the eth-address:
+//
+//	0x0000000000000000000000000000000000000100
+const P256VerifyPrecompile = 0x0100
+
+// Precompile returns the eth-address of precompile number 'n'.
+//
+// For example:
+//
+//	// 0x0000000000000000000000000000000000000001
+//	var ecrecover ethaddr.Address = ethaddr.Precompile(0x01)
+//
+// And also, for example:
+//
+//	// 0x0000000000000000000000000000000000000100
+//	var p256verify ethaddr.Address = ethaddr.Precompile(ethaddr.P256VerifyPrecompile)
+//
+// Note that Precompile does NOT check whether there actually is a precompile at 'n'.
+// (Use the Kind method for that.)
+func Precompile(n uint16) Address {
+	var address [AddressLength]byte
+	address[AddressLength-2] = byte(n >> 8)
+	address[AddressLength-1] = byte(n)
+
+	return Something(address)
+}
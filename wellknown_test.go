@@ -0,0 +1,77 @@
+package ethaddr_test
+
+import (
+	"testing"
+
+	"github.com/reiver/go-ethaddr"
+)
+
+func TestWellKnown(t *testing.T) {
+
+	tests := []struct{
+		Address ethaddr.Address
+		Expected string
+	}{
+		{
+			Address: ethaddr.Zero(),
+			Expected: "0x0000000000000000000000000000000000000000",
+		},
+		{
+			Address: ethaddr.NativeTokenSentinel(),
+			Expected: "0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE",
+		},
+		{
+			Address: ethaddr.Dead(),
+			Expected: "0x000000000000000000000000000000000000dEaD",
+		},
+		{
+			Address: ethaddr.BeaconDepositContract(),
+			Expected: "0x00000000219ab540356cBB839Cbe05303d7705Fa",
+		},
+		{
+			Address: ethaddr.SystemAddress(),
+			Expected: "0xffffFFFfFFffffffffffffffFfFFFfffFFFfFFfE",
+		},
+		{
+			Address: ethaddr.BeaconRootsContract(),
+			Expected: "0x000F3df6D732807Ef1319fB7B8bB8522d0Beac02",
+		},
+		{
+			Address: ethaddr.HistoryStorageContract(),
+			Expected: "0x0000F90827F1C53a10cb7A02335B175320002935",
+		},
+		{
+			Address: ethaddr.WithdrawalRequestContract(),
+			Expected: "0x00000961Ef480Eb55e80D19ad83579A64c007002",
+		},
+		{
+			Address: ethaddr.ConsolidationRequestContract(),
+			Expected: "0x0000BBdDc7CE488642fb579F8B00f3a590007251",
+		},
+
+
+
+		{
+			Address: ethaddr.Precompile(0x01),
+			Expected: "0x0000000000000000000000000000000000000001",
+		},
+		{
+			Address: ethaddr.Precompile(ethaddr.P256VerifyPrecompile),
+			Expected: "0x0000000000000000000000000000000000000100",
+		},
+	}
+
+	for testNumber, test := range tests {
+
+		actual := test.Address.EIP55()
+
+		expected := test.Expected
+
+		if expected != actual {
+			t.Errorf("For test #%d, the actual eth-address is not what was expected.", testNumber)
+			t.Logf("EXPECTED: %s", expected)
+			t.Logf("ACTUAL:   %s", actual)
+			continue
+		}
+	}
+}